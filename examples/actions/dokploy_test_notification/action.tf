@@ -0,0 +1,5 @@
+action "dokploy_test_notification" "slack" {
+  config {
+    notification_id = dokploy_notification_slack.alerts.id
+  }
+}
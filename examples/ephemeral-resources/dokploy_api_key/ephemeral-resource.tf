@@ -0,0 +1,12 @@
+# Short-lived API key used to bootstrap another provider. The key is deleted
+# when Terraform finishes the run and never appears in state.
+ephemeral "dokploy_api_key" "bootstrap" {
+  name       = "terraform-bootstrap"
+  expires_in = 86400
+}
+
+provider "dokploy" {
+  alias   = "bootstrap"
+  host    = var.dokploy_host
+  api_key = ephemeral.dokploy_api_key.bootstrap.key
+}
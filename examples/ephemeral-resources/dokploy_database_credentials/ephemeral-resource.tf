@@ -0,0 +1,12 @@
+ephemeral "dokploy_database_credentials" "app" {
+  database_id   = dokploy_postgres.app.id
+  database_type = "postgres"
+}
+
+provider "postgresql" {
+  host     = var.db_public_host
+  port     = ephemeral.dokploy_database_credentials.app.external_port
+  username = ephemeral.dokploy_database_credentials.app.username
+  password = ephemeral.dokploy_database_credentials.app.password
+  database = ephemeral.dokploy_database_credentials.app.database_name
+}
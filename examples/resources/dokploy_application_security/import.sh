@@ -0,0 +1,2 @@
+# Application security entries can be imported using their ID
+terraform import dokploy_application_security.staging "security-id-123"
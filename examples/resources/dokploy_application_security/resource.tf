@@ -0,0 +1,6 @@
+# Protect a staging application with HTTP basic auth
+resource "dokploy_application_security" "staging" {
+  application_id = dokploy_application.staging.id
+  username       = "staging"
+  password       = var.staging_basic_auth_password
+}
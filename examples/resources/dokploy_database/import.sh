@@ -0,0 +1,2 @@
+# Databases are imported using the type and ID separated by a colon
+terraform import dokploy_database.postgres "postgres:postgres-id-123"
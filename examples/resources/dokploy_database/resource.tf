@@ -0,0 +1,33 @@
+# PostgreSQL with a provider-generated password
+resource "dokploy_database" "postgres" {
+  type           = "postgres"
+  name           = "app-db"
+  app_name       = "app-db"
+  database_name  = "app"
+  database_user  = "app"
+  environment_id = dokploy_environment.production.id
+}
+
+# Engine selected by a module variable
+resource "dokploy_database" "main" {
+  type           = var.engine # postgres, mysql, mariadb, mongo or redis
+  name           = "main-db"
+  app_name       = "main-db"
+  database_name  = contains(["postgres", "mysql", "mariadb"], var.engine) ? "main" : null
+  database_user  = var.engine == "redis" ? null : "main"
+  environment_id = dokploy_environment.production.id
+}
+
+# Redis cache exposed on an external port
+resource "dokploy_database" "cache" {
+  type           = "redis"
+  name           = "app-cache"
+  app_name       = "app-cache"
+  external_port  = 6380
+  environment_id = dokploy_environment.production.id
+}
+
+output "database_url" {
+  value     = dokploy_database.postgres.internal_connection_url
+  sensitive = true
+}
@@ -0,0 +1,2 @@
+# GitHub providers can be imported using their githubId
+terraform import dokploy_github_provider.example "github-id-123"
@@ -0,0 +1,12 @@
+# GitHub providers cannot be created through the Dokploy API. Create the
+# GitHub App in the Dokploy UI, then bring it under Terraform with an import
+# block. Attributes left out keep the values stored in Dokploy.
+import {
+  to = dokploy_github_provider.example
+  id = "github-id-123"
+}
+
+resource "dokploy_github_provider" "example" {
+  name            = "my-github-app"
+  installation_id = "12345678"
+}
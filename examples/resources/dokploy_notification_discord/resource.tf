@@ -0,0 +1,7 @@
+resource "dokploy_notification_discord" "alerts" {
+  name        = "discord-alerts"
+  webhook_url = var.discord_webhook_url
+
+  app_build_error = true
+  docker_cleanup  = true
+}
@@ -0,0 +1,13 @@
+resource "dokploy_notification_email" "ops" {
+  name         = "ops-email"
+  smtp_server  = "smtp.example.com"
+  smtp_port    = 587
+  username     = "dokploy@example.com"
+  password     = var.smtp_password
+  from_address = "dokploy@example.com"
+  to_addresses = ["ops@example.com", "oncall@example.com"]
+
+  app_build_error  = true
+  database_backup  = true
+  server_threshold = true
+}
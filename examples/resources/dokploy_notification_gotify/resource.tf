@@ -0,0 +1,8 @@
+resource "dokploy_notification_gotify" "alerts" {
+  name       = "gotify-alerts"
+  server_url = "https://gotify.example.com"
+  app_token  = var.gotify_app_token
+  priority   = 8
+
+  app_build_error = true
+}
@@ -0,0 +1,7 @@
+resource "dokploy_notification_ntfy" "alerts" {
+  name  = "ntfy-alerts"
+  topic = "dokploy-alerts"
+
+  app_deploy      = true
+  app_build_error = true
+}
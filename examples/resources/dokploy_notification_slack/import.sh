@@ -0,0 +1,2 @@
+# Notifications can be imported using their notificationId
+terraform import dokploy_notification_slack.alerts "notification-id-123"
@@ -0,0 +1,10 @@
+resource "dokploy_notification_slack" "alerts" {
+  name        = "slack-alerts"
+  webhook_url = var.slack_webhook_url
+  channel     = "#deployments"
+
+  app_deploy       = true
+  app_build_error  = true
+  database_backup  = true
+  server_threshold = true
+}
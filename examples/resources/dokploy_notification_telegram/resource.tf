@@ -0,0 +1,8 @@
+resource "dokploy_notification_telegram" "alerts" {
+  name      = "telegram-alerts"
+  bot_token = var.telegram_bot_token
+  chat_id   = "-1001234567890"
+
+  app_build_error = true
+  dokploy_restart = true
+}
@@ -0,0 +1,30 @@
+resource "dokploy_notification_webhook" "alerts" {
+  name     = "alertmanager"
+  endpoint = "https://alerts.example.com/hooks/dokploy"
+  headers = {
+    Authorization = "Bearer ${var.alerts_token}"
+  }
+
+  app_build_error = true
+  database_backup = true
+  docker_cleanup  = true
+}
+
+# Send a test message after every change to the notification.
+# Requires Terraform 1.14 or later.
+action "dokploy_test_notification" "alerts" {
+  config {
+    notification_id = dokploy_notification_webhook.alerts.id
+  }
+}
+
+resource "terraform_data" "alerts_test" {
+  input = dokploy_notification_webhook.alerts
+
+  lifecycle {
+    action_trigger {
+      events  = [after_create, after_update]
+      actions = [action.dokploy_test_notification.alerts]
+    }
+  }
+}
@@ -58,3 +58,15 @@ resource "dokploy_registry" "harbor" {
   password      = var.harbor_robot_token
   image_prefix  = "harbor.example.com/library"
 }
+
+# Write-only password (Terraform 1.11+): the token is never stored in state.
+# Bump password_wo_version to send a rotated token to Dokploy.
+resource "dokploy_registry" "ghcr_write_only" {
+  registry_name       = "GitHub Container Registry (write-only)"
+  registry_type       = "cloud"
+  registry_url        = "ghcr.io"
+  username            = "myorg"
+  password_wo         = var.github_token
+  password_wo_version = 1
+  image_prefix        = "ghcr.io/myorg"
+}
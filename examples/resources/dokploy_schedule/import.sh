@@ -0,0 +1,2 @@
+# Schedules can be imported using their ID
+terraform import dokploy_schedule.cache_warmer "schedule-id-123"
@@ -0,0 +1,31 @@
+# Run a command inside an application container every night
+resource "dokploy_schedule" "cache_warmer" {
+  name            = "cache-warmer"
+  cron_expression = "0 3 * * *"
+  schedule_type   = "application"
+  application_id  = dokploy_application.web.id
+  command         = "php artisan cache:warm"
+  timezone        = "Europe/Berlin"
+}
+
+# Run a command inside one service of a compose stack
+resource "dokploy_schedule" "migration_check" {
+  name            = "migration-check"
+  cron_expression = "*/30 * * * *"
+  schedule_type   = "compose"
+  compose_id      = dokploy_compose.stack.id
+  service_name    = "api"
+  shell_type      = "sh"
+  command         = "./bin/check-migrations"
+
+  # Change this value to run the schedule immediately on the next apply.
+  run_now = "2024-06-01T12:00:00Z"
+}
+
+# Run a script on the Dokploy host
+resource "dokploy_schedule" "prune" {
+  name            = "docker-prune"
+  cron_expression = "0 4 * * 0"
+  schedule_type   = "dokploy-server"
+  script          = "docker system prune -f"
+}
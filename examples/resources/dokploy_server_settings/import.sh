@@ -0,0 +1,2 @@
+# Server settings can be imported using the server ID
+terraform import dokploy_server_settings.worker "server-id-123"
@@ -0,0 +1,8 @@
+# Manage Traefik and Docker cleanup on a remote server
+resource "dokploy_server_settings" "worker" {
+  server_id = dokploy_server.worker.id
+
+  enable_docker_cleanup = true
+  traefik_dashboard     = false
+  middleware_config     = file("${path.module}/traefik/middlewares.yml")
+}
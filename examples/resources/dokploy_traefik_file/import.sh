@@ -0,0 +1,5 @@
+# Traefik files on the Dokploy host can be imported using their file name
+terraform import dokploy_traefik_file.shared_middlewares "shared-middlewares.yml"
+
+# Traefik files on a remote server can be imported using server_id:name
+terraform import dokploy_traefik_file.security_headers "server-id-123:security-headers.yml"
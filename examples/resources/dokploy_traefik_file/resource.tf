@@ -0,0 +1,28 @@
+# Shared middlewares on the Dokploy host
+resource "dokploy_traefik_file" "shared_middlewares" {
+  name = "shared-middlewares.yml"
+  content = yamlencode({
+    http = {
+      middlewares = {
+        rate-limit = {
+          rateLimit = {
+            average = 100
+            burst   = 50
+          }
+        }
+        office-only = {
+          ipAllowList = {
+            sourceRange = ["203.0.113.0/24"]
+          }
+        }
+      }
+    }
+  })
+}
+
+# Security headers on a remote server
+resource "dokploy_traefik_file" "security_headers" {
+  server_id = dokploy_server.worker.id
+  name      = "security-headers.yml"
+  content   = file("${path.module}/traefik/security-headers.yml")
+}
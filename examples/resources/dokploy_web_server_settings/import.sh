@@ -0,0 +1,2 @@
+# The web server settings can be imported using the fixed ID "web-server"
+terraform import dokploy_web_server_settings.main "web-server"
@@ -0,0 +1,14 @@
+# Serve the Dokploy UI over HTTPS and manage the host's Traefik setup
+resource "dokploy_web_server_settings" "main" {
+  host               = "dokploy.example.com"
+  https              = true
+  certificate_type   = "letsencrypt"
+  lets_encrypt_email = "ops@example.com"
+
+  enable_docker_cleanup = true
+  log_cleanup_cron      = "0 0 * * *"
+  traefik_dashboard     = false
+
+  traefik_config    = file("${path.module}/traefik/traefik.yml")
+  middleware_config = file("${path.module}/traefik/middlewares.yml")
+}
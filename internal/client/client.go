@@ -610,6 +610,20 @@ func (c *DokployClient) GetProject(id string) (*Project, error) {
 	return &result, nil
 }
 
+// ListProjects retrieves all projects with their environments and services.
+func (c *DokployClient) ListProjects() ([]Project, error) {
+	resp, err := c.doRequest("GET", "project.all", nil)
+	if err != nil {
+		return nil, err
+	}
+
+	var projects []Project
+	if err := json.Unmarshal(resp, &projects); err != nil {
+		return nil, fmt.Errorf("failed to parse projects response: %w", err)
+	}
+	return projects, nil
+}
+
 func (c *DokployClient) DeleteProject(id string) error {
 	payload := map[string]string{
 		"projectId": id,
@@ -648,6 +662,9 @@ type Environment struct {
 	Mariadb     []Database `json:"mariadb"`
 	Mongo       []Database `json:"mongo"`
 	Redis       []Database `json:"redis"`
+
+	Applications []Application `json:"applications"`
+	Compose      []Compose     `json:"compose"`
 }
 
 func (c *DokployClient) CreateEnvironment(projectID, name, description string) (*Environment, error) {
@@ -675,6 +692,22 @@ func (c *DokployClient) CreateEnvironment(projectID, name, description string) (
 	return &result, nil
 }
 
+// GetEnvironment retrieves an environment with its applications, compose
+// stacks and databases.
+func (c *DokployClient) GetEnvironment(id string) (*Environment, error) {
+	endpoint := fmt.Sprintf("environment.one?environmentId=%s", url.QueryEscape(id))
+	resp, err := c.doRequest("GET", endpoint, nil)
+	if err != nil {
+		return nil, err
+	}
+
+	var env Environment
+	if err := json.Unmarshal(resp, &env); err != nil {
+		return nil, fmt.Errorf("failed to parse environment response: %w", err)
+	}
+	return &env, nil
+}
+
 func (c *DokployClient) UpdateEnvironment(env Environment) (*Environment, error) {
 	payload := map[string]interface{}{
 		"environmentId": env.ID,
@@ -960,6 +993,18 @@ func (c *DokployClient) UpdateApplicationGeneral(app Application) (*Application,
 		payload["entrypoint"] = app.EntryPoint
 	}
 
+	// Docker Swarm fields - always include, null clears the setting
+	payload["healthCheckSwarm"] = app.HealthCheckSwarm
+	payload["restartPolicySwarm"] = app.RestartPolicySwarm
+	payload["placementSwarm"] = app.PlacementSwarm
+	payload["updateConfigSwarm"] = app.UpdateConfigSwarm
+	payload["rollbackConfigSwarm"] = app.RollbackConfigSwarm
+	payload["modeSwarm"] = app.ModeSwarm
+	payload["labelsSwarm"] = app.LabelsSwarm
+	payload["networkSwarm"] = app.NetworkSwarm
+	payload["stopGracePeriodSwarm"] = app.StopGracePeriodSwarm
+	payload["endpointSpecSwarm"] = app.EndpointSpecSwarm
+
 	resp, err := c.doRequest("POST", "application.update", payload)
 	if err != nil {
 		return nil, err
@@ -984,9 +1029,12 @@ func (c *DokployClient) UpdateApplication(app Application) (*Application, error)
 	return c.UpdateApplicationGeneral(app)
 }
 
-func (c *DokployClient) DeleteApplication(id string) error {
-	payload := map[string]string{
+// DeleteApplication removes an application. When deleteVolumes is set, the
+// Docker volumes of its named volume mounts are removed as well.
+func (c *DokployClient) DeleteApplication(id string, deleteVolumes bool) error {
+	payload := map[string]interface{}{
 		"applicationId": id,
+		"deleteVolumes": deleteVolumes,
 	}
 	_, err := c.doRequest("POST", "application.remove", payload)
 	return err
@@ -1772,6 +1820,39 @@ func (c *DokployClient) GetCompose(id string) (*Compose, error) {
 	return &result, nil
 }
 
+// GetConvertedCompose returns the compose file Dokploy deploys for a stack,
+// after applying the suffix, randomization, isolated deployment network and
+// Traefik labels for its domains.
+func (c *DokployClient) GetConvertedCompose(composeID string) (string, error) {
+	endpoint := fmt.Sprintf("compose.getConvertedCompose?composeId=%s", url.QueryEscape(composeID))
+	resp, err := c.doRequest("GET", endpoint, nil)
+	if err != nil {
+		return "", err
+	}
+
+	var content string
+	if err := json.Unmarshal(resp, &content); err != nil {
+		return "", fmt.Errorf("failed to parse converted compose response: %w", err)
+	}
+	return content, nil
+}
+
+// LoadComposeServices returns the service names of a compose stack as last
+// cloned by Dokploy, which also covers stacks sourced from git.
+func (c *DokployClient) LoadComposeServices(composeID string) ([]string, error) {
+	endpoint := fmt.Sprintf("compose.loadServices?composeId=%s&type=cache", url.QueryEscape(composeID))
+	resp, err := c.doRequest("GET", endpoint, nil)
+	if err != nil {
+		return nil, err
+	}
+
+	var services []string
+	if err := json.Unmarshal(resp, &services); err != nil {
+		return nil, fmt.Errorf("failed to parse compose services response: %w", err)
+	}
+	return services, nil
+}
+
 func (c *DokployClient) UpdateCompose(comp Compose) (*Compose, error) {
 	payload := map[string]interface{}{
 		"composeId":  comp.ID,
@@ -1919,9 +2000,12 @@ func (c *DokployClient) UpdateCompose(comp Compose) (*Compose, error) {
 	return &result, nil
 }
 
-func (c *DokployClient) DeleteCompose(id string) error {
-	payload := map[string]string{
-		"composeId": id,
+// DeleteCompose removes a compose. When deleteVolumes is set, the Docker
+// volumes of its services are removed as well.
+func (c *DokployClient) DeleteCompose(id string, deleteVolumes bool) error {
+	payload := map[string]interface{}{
+		"composeId":     id,
+		"deleteVolumes": deleteVolumes,
 	}
 	_, err := c.doRequest("POST", "compose.remove", payload)
 	return err
@@ -1985,6 +2069,71 @@ func (c *DokployClient) ListComposes(environmentID string) ([]Compose, error) {
 	return composes, nil
 }
 
+// --- Compose Templates ---
+
+// ComposeTemplate is an entry of the Dokploy template catalog.
+type ComposeTemplate struct {
+	ID          string `json:"id"`
+	Name        string `json:"name"`
+	Version     string `json:"version"`
+	Description string `json:"description"`
+	Logo        string `json:"logo"`
+	Links       struct {
+		Github  string `json:"github"`
+		Website string `json:"website"`
+		Docs    string `json:"docs"`
+	} `json:"links"`
+	Tags []string `json:"tags"`
+}
+
+// ListComposeTemplates lists the templates of the catalog at baseURL, or of
+// the default Dokploy catalog when baseURL is empty.
+func (c *DokployClient) ListComposeTemplates(baseURL string) ([]ComposeTemplate, error) {
+	endpoint := "compose.templates"
+	if baseURL != "" {
+		endpoint = fmt.Sprintf("%s?baseUrl=%s", endpoint, url.QueryEscape(baseURL))
+	}
+	resp, err := c.doRequest("GET", endpoint, nil)
+	if err != nil {
+		return nil, err
+	}
+
+	var templates []ComposeTemplate
+	if err := json.Unmarshal(resp, &templates); err != nil {
+		return nil, fmt.Errorf("failed to parse templates response: %w", err)
+	}
+	return templates, nil
+}
+
+// DeployComposeTemplate creates a compose stack in an environment from a
+// catalog template, along with the domains, environment and mounts the
+// template generates.
+func (c *DokployClient) DeployComposeTemplate(environmentID, templateID, serverID, baseURL string) (*Compose, error) {
+	payload := map[string]interface{}{
+		"environmentId": environmentID,
+		"id":            templateID,
+	}
+	if serverID != "" {
+		payload["serverId"] = serverID
+	}
+	if baseURL != "" {
+		payload["baseUrl"] = baseURL
+	}
+	resp, err := c.doRequest("POST", "compose.deployTemplate", payload)
+	if err != nil {
+		return nil, err
+	}
+
+	var result Compose
+	if err := json.Unmarshal(resp, &result); err != nil {
+		return nil, fmt.Errorf("failed to parse deploy template response: %w", err)
+	}
+	if result.ID == "" {
+		return nil, fmt.Errorf("deploy template response did not include a compose ID")
+	}
+	return &result, nil
+}
+
 // --- Deployment ---
 
 type Deployment struct {
@@ -2074,172 +2223,36 @@ func (c *DokployClient) ListServerDeployments(serverID string) ([]Deployment, er
 // --- Database ---
 
 type Database struct {
-	ID            string `json:"databaseId"`
-	Name          string `json:"name"`
-	AppName       string `json:"appName"`
-	Type          string `json:"type"`
-	ProjectID     string `json:"projectId"`
-	EnvironmentID string `json:"environmentId"`
-	Version       string `json:"version"`
-	DockerImage   string `json:"dockerImage"`
-	ExternalPort  int64  `json:"externalPort"`
-	InternalPort  int64  `json:"internalPort"`
-	Password      string `json:"password"`
-	PostgresID    string `json:"postgresId"`
-	MysqlID       string `json:"mysqlId"`
-	MariadbID     string `json:"mariadbId"`
-	MongoID       string `json:"mongoId"`
-	RedisID       string `json:"redisId"`
-}
-
-func (c *DokployClient) CreateDatabase(projectID, environmentID, name, dbType, password, dockerImage string) (*Database, error) {
-	var endpoint string
-	payload := map[string]string{
-		"environmentId":    environmentID,
-		"name":             name,
-		"appName":          name,
-		"databasePassword": password,
-		"dockerImage":      dockerImage,
-	}
-
-	switch dbType {
-	case "postgres":
-		endpoint = "postgres.create"
-		payload["databaseName"] = name
-		payload["databaseUser"] = "postgres"
-	case "mysql":
-		endpoint = "mysql.create"
-		payload["databaseName"] = name
-		payload["databaseUser"] = "root"
-		payload["databaseRootPassword"] = password // MySQL requires separate root password.
-	case "mariadb":
-		endpoint = "mariadb.create"
-		payload["databaseName"] = name
-		payload["databaseUser"] = "root"
-		payload["databaseRootPassword"] = password // MariaDB requires separate root password.
-	case "mongo":
-		// MongoDB does NOT accept databaseName in create API.
-		endpoint = "mongo.create"
-		payload["databaseUser"] = "mongo"
-	case "redis":
-		endpoint = "redis.create"
-		payload["databaseUser"] = "default"
-	default:
-		return nil, fmt.Errorf("unsupported database type: %s", dbType)
-	}
-
-	resp, err := c.doRequest("POST", endpoint, payload)
-	if err != nil {
-		return nil, err
-	}
-
-	if string(resp) == "true" {
-		project, err := c.GetProject(projectID)
-		if err != nil {
-			return nil, fmt.Errorf("database created but failed to fetch project: %w", err)
-		}
-
-		for _, env := range project.Environments {
-			if env.ID == environmentID {
-				var dbs []Database
-				switch dbType {
-				case "postgres":
-					dbs = env.Postgres
-				case "mysql":
-					dbs = env.Mysql
-				case "mariadb":
-					dbs = env.Mariadb
-				case "mongo":
-					dbs = env.Mongo
-				case "redis":
-					dbs = env.Redis
-				}
-
-				for _, db := range dbs {
-					if db.Name == name || db.AppName == name {
-						id := db.PostgresID
-						if db.MysqlID != "" {
-							id = db.MysqlID
-						}
-						if db.MariadbID != "" {
-							id = db.MariadbID
-						}
-						if db.MongoID != "" {
-							id = db.MongoID
-						}
-						if db.RedisID != "" {
-							id = db.RedisID
-						}
-						if id != "" {
-							db.ID = id
-						}
-
-						if db.Type == "" {
-							db.Type = dbType
-						}
-						return &db, nil
-					}
-				}
-			}
-		}
-		return nil, fmt.Errorf("database created but not found in project environments")
-	}
-
-	var wrapper struct {
-		Database Database `json:"database"`
-	}
-	if err := json.Unmarshal(resp, &wrapper); err == nil {
-		db := wrapper.Database
-
-		// Extract ID from type-specific fields if generic ID is not set
-		if db.ID == "" {
-			switch dbType {
-			case "postgres":
-				db.ID = db.PostgresID
-			case "mysql":
-				db.ID = db.MysqlID
-			case "mariadb":
-				db.ID = db.MariadbID
-			case "mongo":
-				db.ID = db.MongoID
-			case "redis":
-				db.ID = db.RedisID
-			}
-		}
-
-		if db.ID != "" {
-			if db.Type == "" {
-				db.Type = dbType
-			}
-			return &db, nil
-		}
-	}
-
-	var result Database
-	if err := json.Unmarshal(resp, &result); err != nil {
-		return nil, err
-	}
-	if result.Type == "" {
-		result.Type = dbType
-	}
-
-	// Extract ID from type-specific fields if generic ID is not set
-	if result.ID == "" {
-		switch dbType {
-		case "postgres":
-			result.ID = result.PostgresID
-		case "mysql":
-			result.ID = result.MysqlID
-		case "mariadb":
-			result.ID = result.MariadbID
-		case "mongo":
-			result.ID = result.MongoID
-		case "redis":
-			result.ID = result.RedisID
-		}
-	}
-
-	return &result, nil
+	ID                string `json:"databaseId"`
+	Name              string `json:"name"`
+	AppName           string `json:"appName"`
+	Description       string `json:"description"`
+	Type              string `json:"type"`
+	ProjectID         string `json:"projectId"`
+	EnvironmentID     string `json:"environmentId"`
+	Version           string `json:"version"`
+	DockerImage       string `json:"dockerImage"`
+	ExternalPort      int64  `json:"externalPort"`
+	InternalPort      int64  `json:"internalPort"`
+	Password          string `json:"databasePassword"`
+	RootPassword      string `json:"databaseRootPassword"`
+	DatabaseName      string `json:"databaseName"`
+	DatabaseUser      string `json:"databaseUser"`
+	ReplicaSets       bool   `json:"replicaSets"`
+	Command           string `json:"command"`
+	Env               string `json:"env"`
+	MemoryReservation string `json:"memoryReservation"`
+	MemoryLimit       string `json:"memoryLimit"`
+	CPUReservation    string `json:"cpuReservation"`
+	CPULimit          string `json:"cpuLimit"`
+	ApplicationStatus string `json:"applicationStatus"`
+	Replicas          int64  `json:"replicas"`
+	ServerID          string `json:"serverId"`
+	PostgresID        string `json:"postgresId"`
+	MysqlID           string `json:"mysqlId"`
+	MariadbID         string `json:"mariadbId"`
+	MongoID           string `json:"mongoId"`
+	RedisID           string `json:"redisId"`
 }
 
 func (c *DokployClient) GetDatabase(dbID string, databaseType string) (*Database, error) {
@@ -2368,7 +2381,9 @@ func (c *DokployClient) DeleteDatabase(id string) error {
 	return fmt.Errorf("delete database requires type update")
 }
 
-func (c *DokployClient) DeleteDatabaseWithType(id, dbType string) error {
+// DeleteDatabaseWithType removes a database of dbType. When deleteVolumes is
+// set, its data volume is removed as well.
+func (c *DokployClient) DeleteDatabaseWithType(id, dbType string, deleteVolumes bool) error {
 	var endpoint string
 	var idKey string
 	switch dbType {
@@ -2391,50 +2406,310 @@ func (c *DokployClient) DeleteDatabaseWithType(id, dbType string) error {
 		return fmt.Errorf("unsupported database type: %s", dbType)
 	}
 
+	payload := map[string]interface{}{
+		idKey:           id,
+		"deleteVolumes": deleteVolumes,
+	}
+	_, err := c.doRequest("POST", endpoint, payload)
+	return err
+}
+
+// MoveDatabaseWithType moves a database of dbType to a different environment.
+func (c *DokployClient) MoveDatabaseWithType(id, dbType, targetEnvironmentID string) error {
+	var endpoint string
+	var idKey string
+	switch dbType {
+	case "postgres":
+		endpoint = "postgres.move"
+		idKey = "postgresId"
+	case "mysql":
+		endpoint = "mysql.move"
+		idKey = "mysqlId"
+	case "mariadb":
+		endpoint = "mariadb.move"
+		idKey = "mariadbId"
+	case "mongo":
+		endpoint = "mongo.move"
+		idKey = "mongoId"
+	case "redis":
+		endpoint = "redis.move"
+		idKey = "redisId"
+	default:
+		return fmt.Errorf("unsupported database type: %s", dbType)
+	}
+
 	payload := map[string]string{
-		idKey: id,
+		idKey:                 id,
+		"targetEnvironmentId": targetEnvironmentID,
 	}
 	_, err := c.doRequest("POST", endpoint, payload)
 	return err
 }
 
+// CreateDatabaseWithType creates a database of db.Type using the type-specific
+// create endpoint, then applies the fields that endpoint does not accept
+// through the update endpoint.
+func (c *DokployClient) CreateDatabaseWithType(db Database) (*Database, error) {
+	var id string
+	switch db.Type {
+	case "postgres":
+		created, err := c.CreatePostgres(db.postgres())
+		if err != nil {
+			return nil, err
+		}
+		id = created.PostgresID
+	case "mysql":
+		created, err := c.CreateMySQL(db.mysql())
+		if err != nil {
+			return nil, err
+		}
+		id = created.MySQLID
+	case "mariadb":
+		created, err := c.CreateMariaDB(db.mariadb())
+		if err != nil {
+			return nil, err
+		}
+		id = created.MariaDBID
+	case "mongo":
+		created, err := c.CreateMongoDB(db.mongo())
+		if err != nil {
+			return nil, err
+		}
+		id = created.MongoID
+	case "redis":
+		created, err := c.CreateRedis(db.redis())
+		if err != nil {
+			return nil, err
+		}
+		id = created.RedisID
+	default:
+		return nil, fmt.Errorf("unsupported database type: %s", db.Type)
+	}
+
+	if db.Command != "" || db.Env != "" || db.MemoryReservation != "" || db.MemoryLimit != "" ||
+		db.CPUReservation != "" || db.CPULimit != "" || db.ExternalPort > 0 || db.Replicas > 0 {
+		update := Database{
+			ID:                id,
+			Type:              db.Type,
+			ReplicaSets:       db.ReplicaSets,
+			Command:           db.Command,
+			Env:               db.Env,
+			MemoryReservation: db.MemoryReservation,
+			MemoryLimit:       db.MemoryLimit,
+			CPUReservation:    db.CPUReservation,
+			CPULimit:          db.CPULimit,
+			ExternalPort:      db.ExternalPort,
+			Replicas:          db.Replicas,
+		}
+		return c.UpdateDatabaseWithType(update)
+	}
+
+	return c.GetDatabase(id, db.Type)
+}
+
+// UpdateDatabaseWithType updates a database of db.Type using the type-specific
+// update endpoint. Empty fields are left unchanged.
+func (c *DokployClient) UpdateDatabaseWithType(db Database) (*Database, error) {
+	var err error
+	switch db.Type {
+	case "postgres":
+		_, err = c.UpdatePostgres(db.postgres())
+	case "mysql":
+		_, err = c.UpdateMySQL(db.mysql())
+	case "mariadb":
+		_, err = c.UpdateMariaDB(db.mariadb())
+	case "mongo":
+		_, err = c.UpdateMongoDB(db.mongo())
+	case "redis":
+		_, err = c.UpdateRedis(db.redis())
+	default:
+		return nil, fmt.Errorf("unsupported database type: %s", db.Type)
+	}
+	if err != nil {
+		return nil, err
+	}
+	return c.GetDatabase(db.ID, db.Type)
+}
+
+func (db Database) postgres() Postgres {
+	return Postgres{
+		PostgresID:        db.ID,
+		Name:              db.Name,
+		AppName:           db.AppName,
+		Description:       db.Description,
+		DatabaseName:      db.DatabaseName,
+		DatabaseUser:      db.DatabaseUser,
+		DatabasePassword:  db.Password,
+		DockerImage:       db.DockerImage,
+		Command:           db.Command,
+		Env:               db.Env,
+		MemoryReservation: db.MemoryReservation,
+		MemoryLimit:       db.MemoryLimit,
+		CPUReservation:    db.CPUReservation,
+		CPULimit:          db.CPULimit,
+		ExternalPort:      int(db.ExternalPort),
+		EnvironmentID:     db.EnvironmentID,
+		Replicas:          int(db.Replicas),
+		ServerID:          db.ServerID,
+	}
+}
+
+func (db Database) mysql() MySQL {
+	return MySQL{
+		MySQLID:              db.ID,
+		Name:                 db.Name,
+		AppName:              db.AppName,
+		Description:          db.Description,
+		DatabaseName:         db.DatabaseName,
+		DatabaseUser:         db.DatabaseUser,
+		DatabasePassword:     db.Password,
+		DatabaseRootPassword: db.RootPassword,
+		DockerImage:          db.DockerImage,
+		Command:              db.Command,
+		Env:                  db.Env,
+		MemoryReservation:    db.MemoryReservation,
+		MemoryLimit:          db.MemoryLimit,
+		CPUReservation:       db.CPUReservation,
+		CPULimit:             db.CPULimit,
+		ExternalPort:         int(db.ExternalPort),
+		EnvironmentID:        db.EnvironmentID,
+		Replicas:             int(db.Replicas),
+		ServerID:             db.ServerID,
+	}
+}
+
+func (db Database) mariadb() MariaDB {
+	return MariaDB{
+		MariaDBID:            db.ID,
+		Name:                 db.Name,
+		AppName:              db.AppName,
+		Description:          db.Description,
+		DatabaseName:         db.DatabaseName,
+		DatabaseUser:         db.DatabaseUser,
+		DatabasePassword:     db.Password,
+		DatabaseRootPassword: db.RootPassword,
+		DockerImage:          db.DockerImage,
+		Command:              db.Command,
+		Env:                  db.Env,
+		MemoryReservation:    db.MemoryReservation,
+		MemoryLimit:          db.MemoryLimit,
+		CPUReservation:       db.CPUReservation,
+		CPULimit:             db.CPULimit,
+		ExternalPort:         int(db.ExternalPort),
+		EnvironmentID:        db.EnvironmentID,
+		Replicas:             int(db.Replicas),
+		ServerID:             db.ServerID,
+	}
+}
+
+func (db Database) mongo() MongoDB {
+	return MongoDB{
+		MongoID:           db.ID,
+		Name:              db.Name,
+		AppName:           db.AppName,
+		Description:       db.Description,
+		DatabaseUser:      db.DatabaseUser,
+		DatabasePassword:  db.Password,
+		ReplicaSets:       db.ReplicaSets,
+		DockerImage:       db.DockerImage,
+		Command:           db.Command,
+		Env:               db.Env,
+		MemoryReservation: db.MemoryReservation,
+		MemoryLimit:       db.MemoryLimit,
+		CPUReservation:    db.CPUReservation,
+		CPULimit:          db.CPULimit,
+		ExternalPort:      int(db.ExternalPort),
+		EnvironmentID:     db.EnvironmentID,
+		Replicas:          int(db.Replicas),
+		ServerID:          db.ServerID,
+	}
+}
+
+func (db Database) redis() Redis {
+	return Redis{
+		RedisID:           db.ID,
+		Name:              db.Name,
+		AppName:           db.AppName,
+		Description:       db.Description,
+		DatabasePassword:  db.Password,
+		DockerImage:       db.DockerImage,
+		Command:           db.Command,
+		Env:               db.Env,
+		MemoryReservation: db.MemoryReservation,
+		MemoryLimit:       db.MemoryLimit,
+		CPUReservation:    db.CPUReservation,
+		CPULimit:          db.CPULimit,
+		ExternalPort:      int(db.ExternalPort),
+		EnvironmentID:     db.EnvironmentID,
+		Replicas:          int(db.Replicas),
+		ServerID:          db.ServerID,
+	}
+}
+
 // --- Domain ---
 
 type Domain struct {
-	ID              string `json:"domainId"`
-	ApplicationID   string `json:"applicationId"`
-	ComposeID       string `json:"composeId"`
-	ServiceName     string `json:"serviceName"`
-	Host            string `json:"host"`
-	Path            string `json:"path"`
-	Port            int64  `json:"port"`
-	HTTPS           bool   `json:"https"`
-	CertificateType string `json:"certificateType"`
+	ID                  string  `json:"domainId"`
+	ApplicationID       string  `json:"applicationId"`
+	ComposeID           string  `json:"composeId"`
+	PreviewDeploymentID string  `json:"previewDeploymentId"`
+	DomainType          string  `json:"domainType"`
+	ServiceName         string  `json:"serviceName"`
+	Host                string  `json:"host"`
+	Path                string  `json:"path"`
+	InternalPath        string  `json:"internalPath"`
+	StripPath           bool    `json:"stripPath"`
+	Port                int64   `json:"port"`
+	HTTPS               bool    `json:"https"`
+	CertificateType     string  `json:"certificateType"`
+	CustomCertResolver  *string `json:"customCertResolver"`
+	CreatedAt           string  `json:"createdAt"`
 }
 
-func (c *DokployClient) CreateDomain(domain Domain) (*Domain, error) {
-	payload := map[string]interface{}{
-		"host":  domain.Host,
-		"path":  domain.Path,
-		"port":  domain.Port,
-		"https": domain.HTTPS,
-	}
+// routingPayload adds the Traefik routing options shared by domain create and
+// update requests.
+func (d Domain) routingPayload(payload map[string]interface{}) {
 	// Set certificate type based on HTTPS setting
-	if domain.HTTPS {
-		if domain.CertificateType != "" {
-			payload["certificateType"] = domain.CertificateType
+	if d.HTTPS {
+		if d.CertificateType != "" {
+			payload["certificateType"] = d.CertificateType
 		} else {
 			payload["certificateType"] = "letsencrypt"
 		}
 	} else {
 		payload["certificateType"] = "none"
 	}
+	if d.CertificateType == "custom" && d.CustomCertResolver != nil {
+		payload["customCertResolver"] = *d.CustomCertResolver
+	} else {
+		payload["customCertResolver"] = nil
+	}
+	if d.InternalPath != "" {
+		payload["internalPath"] = d.InternalPath
+	}
+	payload["stripPath"] = d.StripPath
+	if d.DomainType != "" {
+		payload["domainType"] = d.DomainType
+	}
+}
+
+func (c *DokployClient) CreateDomain(domain Domain) (*Domain, error) {
+	payload := map[string]interface{}{
+		"host":  domain.Host,
+		"path":  domain.Path,
+		"port":  domain.Port,
+		"https": domain.HTTPS,
+	}
+	domain.routingPayload(payload)
 	if domain.ApplicationID != "" {
 		payload["applicationId"] = domain.ApplicationID
 	}
 	if domain.ComposeID != "" {
 		payload["composeId"] = domain.ComposeID
 	}
+	if domain.PreviewDeploymentID != "" {
+		payload["previewDeploymentId"] = domain.PreviewDeploymentID
+	}
 	if domain.ServiceName != "" {
 		payload["serviceName"] = domain.ServiceName
 	}
@@ -2458,6 +2733,21 @@ func (c *DokployClient) CreateDomain(domain Domain) (*Domain, error) {
 	return &result, nil
 }
 
+// GetDomain retrieves a domain by ID.
+func (c *DokployClient) GetDomain(id string) (*Domain, error) {
+	endpoint := fmt.Sprintf("domain.one?domainId=%s", id)
+	resp, err := c.doRequest("GET", endpoint, nil)
+	if err != nil {
+		return nil, err
+	}
+
+	var domain Domain
+	if err := json.Unmarshal(resp, &domain); err != nil {
+		return nil, err
+	}
+	return &domain, nil
+}
+
 func (c *DokployClient) GetDomainsByApplication(appID string) ([]Domain, error) {
 	app, err := c.GetApplication(appID)
 	if err != nil {
@@ -2504,25 +2794,46 @@ func (c *DokployClient) GenerateDomain(appName string) (string, error) {
 	return strings.Trim(string(resp), "\""), nil
 }
 
-func (c *DokployClient) UpdateDomain(domain Domain) (*Domain, error) {
+// DomainValidation is the result of resolving a domain and comparing it
+// against a server IP.
+type DomainValidation struct {
+	IsValid      bool   `json:"isValid"`
+	ResolvedIP   string `json:"resolvedIp"`
+	IsCloudflare bool   `json:"isCloudflare"`
+	Error        string `json:"error"`
+}
+
+// ValidateDomain asks Dokploy to resolve host and check that it points at
+// serverIP. Hosts proxied through Cloudflare are reported as valid.
+func (c *DokployClient) ValidateDomain(host, serverIP string) (*DomainValidation, error) {
 	payload := map[string]interface{}{
-		"domainId":    domain.ID,
+		"domain": host,
+	}
+	if serverIP != "" {
+		payload["serverIp"] = serverIP
+	}
+	resp, err := c.doRequest("POST", "domain.validateDomain", payload)
+	if err != nil {
+		return nil, err
+	}
+
+	var result DomainValidation
+	if err := json.Unmarshal(resp, &result); err != nil {
+		return nil, fmt.Errorf("failed to parse domain validation response: %w", err)
+	}
+	return &result, nil
+}
+
+func (c *DokployClient) UpdateDomain(domain Domain) (*Domain, error) {
+	payload := map[string]interface{}{
+		"domainId":    domain.ID,
 		"host":        domain.Host,
 		"path":        domain.Path,
 		"port":        domain.Port,
 		"https":       domain.HTTPS,
 		"serviceName": domain.ServiceName,
 	}
-	// Set certificate type based on HTTPS setting
-	if domain.HTTPS {
-		if domain.CertificateType != "" {
-			payload["certificateType"] = domain.CertificateType
-		} else {
-			payload["certificateType"] = "letsencrypt"
-		}
-	} else {
-		payload["certificateType"] = "none"
-	}
+	domain.routingPayload(payload)
 	resp, err := c.doRequest("POST", "domain.update", payload)
 	if err != nil {
 		return nil, err
@@ -3366,6 +3677,107 @@ func (c *DokployClient) DeleteRedirect(id string) error {
 	return err
 }
 
+// --- Security ---
+
+// Security is a set of HTTP basic auth credentials protecting an application.
+type Security struct {
+	ID            string `json:"securityId"`
+	Username      string `json:"username"`
+	Password      string `json:"password"`
+	ApplicationID string `json:"applicationId"`
+	CreatedAt     string `json:"createdAt"`
+}
+
+// GetSecuritiesByApplication fetches the basic auth credentials of an
+// application from the security array of application.one.
+func (c *DokployClient) GetSecuritiesByApplication(applicationID string) ([]Security, error) {
+	endpoint := fmt.Sprintf("application.one?applicationId=%s", applicationID)
+	resp, err := c.doRequest("GET", endpoint, nil)
+	if err != nil {
+		return nil, err
+	}
+
+	var appResponse struct {
+		Security []Security `json:"security"`
+	}
+	if err := json.Unmarshal(resp, &appResponse); err != nil {
+		return nil, fmt.Errorf("failed to parse application response: %w", err)
+	}
+
+	return appResponse.Security, nil
+}
+
+func (c *DokployClient) CreateSecurity(security Security) (*Security, error) {
+	payload := map[string]interface{}{
+		"applicationId": security.ApplicationID,
+		"username":      security.Username,
+		"password":      security.Password,
+	}
+
+	resp, err := c.doRequest("POST", "security.create", payload)
+	if err != nil {
+		return nil, err
+	}
+
+	var result Security
+	if err := json.Unmarshal(resp, &result); err == nil && result.ID != "" {
+		return &result, nil
+	}
+
+	// API returns boolean true on success - look the credentials up by username
+	securities, err := c.GetSecuritiesByApplication(security.ApplicationID)
+	if err != nil {
+		return nil, fmt.Errorf("security created but failed to fetch security details: %w", err)
+	}
+	for i := range securities {
+		if securities[i].Username == security.Username {
+			return &securities[i], nil
+		}
+	}
+	return nil, fmt.Errorf("security created but could not find it in application security")
+}
+
+func (c *DokployClient) GetSecurity(id string) (*Security, error) {
+	endpoint := fmt.Sprintf("security.one?securityId=%s", id)
+	resp, err := c.doRequest("GET", endpoint, nil)
+	if err != nil {
+		return nil, err
+	}
+
+	var result Security
+	if err := json.Unmarshal(resp, &result); err != nil {
+		return nil, err
+	}
+	return &result, nil
+}
+
+func (c *DokployClient) UpdateSecurity(security Security) (*Security, error) {
+	payload := map[string]interface{}{
+		"securityId": security.ID,
+		"username":   security.Username,
+		"password":   security.Password,
+	}
+
+	resp, err := c.doRequest("POST", "security.update", payload)
+	if err != nil {
+		return nil, err
+	}
+
+	var result Security
+	if err := json.Unmarshal(resp, &result); err != nil || result.ID == "" {
+		return c.GetSecurity(security.ID)
+	}
+	return &result, nil
+}
+
+func (c *DokployClient) DeleteSecurity(id string) error {
+	payload := map[string]string{
+		"securityId": id,
+	}
+	_, err := c.doRequest("POST", "security.delete", payload)
+	return err
+}
+
 // --- Registry ---
 
 type Registry struct {
@@ -4057,9 +4469,10 @@ func (c *DokployClient) UpdatePostgres(postgres Postgres) (*Postgres, error) {
 }
 
 // DeletePostgres removes a PostgreSQL instance by ID.
-func (c *DokployClient) DeletePostgres(id string) error {
-	payload := map[string]string{
-		"postgresId": id,
+func (c *DokployClient) DeletePostgres(id string, deleteVolumes bool) error {
+	payload := map[string]interface{}{
+		"postgresId":    id,
+		"deleteVolumes": deleteVolumes,
 	}
 	_, err := c.doRequest("POST", "postgres.remove", payload)
 	return err
@@ -4212,9 +4625,10 @@ func (c *DokployClient) UpdateMySQL(mysql MySQL) (*MySQL, error) {
 }
 
 // DeleteMySQL removes a MySQL instance by ID.
-func (c *DokployClient) DeleteMySQL(id string) error {
-	payload := map[string]string{
-		"mysqlId": id,
+func (c *DokployClient) DeleteMySQL(id string, deleteVolumes bool) error {
+	payload := map[string]interface{}{
+		"mysqlId":       id,
+		"deleteVolumes": deleteVolumes,
 	}
 	_, err := c.doRequest("POST", "mysql.remove", payload)
 	return err
@@ -4367,9 +4781,10 @@ func (c *DokployClient) UpdateMariaDB(mariadb MariaDB) (*MariaDB, error) {
 }
 
 // DeleteMariaDB removes a MariaDB instance by ID.
-func (c *DokployClient) DeleteMariaDB(id string) error {
-	payload := map[string]string{
-		"mariadbId": id,
+func (c *DokployClient) DeleteMariaDB(id string, deleteVolumes bool) error {
+	payload := map[string]interface{}{
+		"mariadbId":     id,
+		"deleteVolumes": deleteVolumes,
 	}
 	_, err := c.doRequest("POST", "mariadb.remove", payload)
 	return err
@@ -4519,9 +4934,10 @@ func (c *DokployClient) UpdateMongoDB(mongo MongoDB) (*MongoDB, error) {
 }
 
 // DeleteMongoDB removes a MongoDB instance by ID.
-func (c *DokployClient) DeleteMongoDB(id string) error {
-	payload := map[string]string{
-		"mongoId": id,
+func (c *DokployClient) DeleteMongoDB(id string, deleteVolumes bool) error {
+	payload := map[string]interface{}{
+		"mongoId":       id,
+		"deleteVolumes": deleteVolumes,
 	}
 	_, err := c.doRequest("POST", "mongo.remove", payload)
 	return err
@@ -4666,9 +5082,10 @@ func (c *DokployClient) UpdateRedis(redis Redis) (*Redis, error) {
 }
 
 // DeleteRedis removes a Redis instance by ID.
-func (c *DokployClient) DeleteRedis(id string) error {
-	payload := map[string]string{
-		"redisId": id,
+func (c *DokployClient) DeleteRedis(id string, deleteVolumes bool) error {
+	payload := map[string]interface{}{
+		"redisId":       id,
+		"deleteVolumes": deleteVolumes,
 	}
 	_, err := c.doRequest("POST", "redis.remove", payload)
 	return err
@@ -5042,6 +5459,101 @@ func (c *DokployClient) ListBitbucketProviders() ([]BitbucketProviderListItem, e
 	return nil, fmt.Errorf("failed to parse bitbucket providers response")
 }
 
+// GithubAppProvider is the full structure used for create/update operations
+// on a GitHub App connection.
+type GithubAppProvider struct {
+	ID                   string          `json:"githubId"`
+	GitProviderId        string          `json:"gitProviderId"`
+	Name                 string          `json:"name"`
+	GithubAppName        string          `json:"githubAppName"`
+	GithubAppId          int64           `json:"githubAppId"`
+	GithubClientId       string          `json:"githubClientId"`
+	GithubClientSecret   string          `json:"githubClientSecret"`
+	GithubInstallationId string          `json:"githubInstallationId"`
+	GithubPrivateKey     string          `json:"githubPrivateKey"`
+	GithubWebhookSecret  string          `json:"githubWebhookSecret"`
+	OrganizationID       string          `json:"organizationId"`
+	CreatedAt            string          `json:"createdAt"`
+	GitProvider          GitProviderInfo `json:"gitProvider"`
+}
+
+// normalize fills the top-level fields that the API only returns on the
+// nested gitProvider object.
+func (p *GithubAppProvider) normalize() {
+	if p.GitProviderId == "" {
+		p.GitProviderId = p.GitProvider.GitProviderId
+	}
+	if p.Name == "" {
+		p.Name = p.GitProvider.Name
+	}
+	if p.OrganizationID == "" {
+		p.OrganizationID = p.GitProvider.OrganizationID
+	}
+	if p.CreatedAt == "" {
+		p.CreatedAt = p.GitProvider.CreatedAt
+	}
+}
+
+func githubProviderPayload(provider GithubAppProvider) map[string]interface{} {
+	payload := map[string]interface{}{
+		"name":               provider.Name,
+		"githubAppId":        provider.GithubAppId,
+		"githubClientId":     provider.GithubClientId,
+		"githubClientSecret": provider.GithubClientSecret,
+		"githubPrivateKey":   provider.GithubPrivateKey,
+	}
+
+	if provider.GithubAppName != "" {
+		payload["githubAppName"] = provider.GithubAppName
+	}
+	if provider.GithubInstallationId != "" {
+		payload["githubInstallationId"] = provider.GithubInstallationId
+	}
+	if provider.GithubWebhookSecret != "" {
+		payload["githubWebhookSecret"] = provider.GithubWebhookSecret
+	}
+	return payload
+}
+
+func (c *DokployClient) GetGithubProvider(id string) (*GithubAppProvider, error) {
+	endpoint := fmt.Sprintf("github.one?githubId=%s", id)
+	resp, err := c.doRequest("GET", endpoint, nil)
+	if err != nil {
+		return nil, err
+	}
+
+	var result GithubAppProvider
+	if err := json.Unmarshal(resp, &result); err != nil {
+		return nil, err
+	}
+	result.normalize()
+	return &result, nil
+}
+
+func (c *DokployClient) UpdateGithubProvider(provider GithubAppProvider) (*GithubAppProvider, error) {
+	payload := githubProviderPayload(provider)
+	payload["githubId"] = provider.ID
+	if provider.GitProviderId != "" {
+		payload["gitProviderId"] = provider.GitProviderId
+	}
+
+	resp, err := c.doRequest("POST", "github.update", payload)
+	if err != nil {
+		return nil, err
+	}
+
+	if len(resp) == 0 || string(resp) == "true" {
+		return c.GetGithubProvider(provider.ID)
+	}
+
+	var result GithubAppProvider
+	if err := json.Unmarshal(resp, &result); err != nil || result.ID == "" {
+		return c.GetGithubProvider(provider.ID)
+	}
+	result.normalize()
+	return &result, nil
+}
+
 // --- Gitea Provider ---
 
 // GiteaProviderListItem is the structure returned by the giteaProviders list endpoint.
@@ -5483,6 +5995,620 @@ func (c *DokployClient) ListVolumeBackups(serviceID, serviceType string) ([]Volu
 	return result, nil
 }
 
+// --- Settings ---
+
+// Paths of the main Traefik configuration, the middleware dynamic
+// configuration and the dynamic configuration directory on Dokploy-managed
+// hosts.
+const (
+	TraefikMainConfigPath       = "/etc/dokploy/traefik/traefik.yml"
+	TraefikMiddlewareConfigPath = "/etc/dokploy/traefik/dynamic/middlewares.yml"
+	TraefikDynamicConfigDir     = "/etc/dokploy/traefik/dynamic"
+	TraefikAcmePath             = "/etc/dokploy/traefik/dynamic/acme.json"
+)
+
+// WebServerSettings holds the settings of the Dokploy host, which the API
+// returns as part of the current user.
+type WebServerSettings struct {
+	Host                string  `json:"host"`
+	HTTPS               bool    `json:"https"`
+	CertificateType     string  `json:"certificateType"`
+	LetsEncryptEmail    string  `json:"letsEncryptEmail"`
+	EnableDockerCleanup bool    `json:"enableDockerCleanup"`
+	LogCleanupCron      *string `json:"logCleanupCron"`
+	ServerIP            string  `json:"serverIp"`
+}
+
+func (c *DokployClient) GetWebServerSettings() (*WebServerSettings, error) {
+	resp, err := c.doRequest("GET", "user.get", nil)
+	if err != nil {
+		return nil, err
+	}
+
+	var result struct {
+		User WebServerSettings `json:"user"`
+	}
+	if err := json.Unmarshal(resp, &result); err != nil {
+		return nil, fmt.Errorf("failed to parse user response: %w", err)
+	}
+	return &result.User, nil
+}
+
+// AssignWebServerDomain sets the domain, HTTPS and certificate settings used
+// to serve the Dokploy UI.
+func (c *DokployClient) AssignWebServerDomain(settings WebServerSettings) error {
+	payload := map[string]interface{}{
+		"host":             settings.Host,
+		"https":            settings.HTTPS,
+		"certificateType":  settings.CertificateType,
+		"letsEncryptEmail": settings.LetsEncryptEmail,
+	}
+	_, err := c.doRequest("POST", "settings.assignDomainServer", payload)
+	return err
+}
+
+// UpdateDockerCleanup toggles the scheduled Docker cleanup on the Dokploy
+// host, or on a remote server when serverID is set.
+func (c *DokployClient) UpdateDockerCleanup(enable bool, serverID string) error {
+	payload := map[string]interface{}{
+		"enableDockerCleanup": enable,
+	}
+	if serverID != "" {
+		payload["serverId"] = serverID
+	}
+	_, err := c.doRequest("POST", "settings.updateDockerCleanup", payload)
+	return err
+}
+
+// UpdateLogCleanup sets the cron schedule for rotating Traefik access logs.
+// An empty schedule disables log rotation.
+func (c *DokployClient) UpdateLogCleanup(cronExpression string) error {
+	payload := map[string]interface{}{
+		"cronExpression": nil,
+	}
+	if cronExpression != "" {
+		payload["cronExpression"] = cronExpression
+	}
+	_, err := c.doRequest("POST", "settings.updateLogCleanup", payload)
+	return err
+}
+
+// IsTraefikDashboardEnabled reports whether the Traefik dashboard port is
+// exposed on the Dokploy host, or on a remote server when serverID is set.
+func (c *DokployClient) IsTraefikDashboardEnabled(serverID string) (bool, error) {
+	endpoint := "settings.haveTraefikDashboardPortEnabled"
+	if serverID != "" {
+		endpoint = fmt.Sprintf("%s?serverId=%s", endpoint, url.QueryEscape(serverID))
+	}
+	resp, err := c.doRequest("GET", endpoint, nil)
+	if err != nil {
+		return false, err
+	}
+
+	var enabled bool
+	if err := json.Unmarshal(resp, &enabled); err != nil {
+		return false, fmt.Errorf("failed to parse Traefik dashboard response: %w", err)
+	}
+	return enabled, nil
+}
+
+func (c *DokployClient) ToggleTraefikDashboard(enable bool, serverID string) error {
+	payload := map[string]interface{}{
+		"enableDashboard": enable,
+	}
+	if serverID != "" {
+		payload["serverId"] = serverID
+	}
+	_, err := c.doRequest("POST", "settings.toggleDashboard", payload)
+	return err
+}
+
+// AcmeCertificate is a certificate Traefik obtained through an ACME resolver.
+type AcmeCertificate struct {
+	Resolver    string
+	Main        string
+	SANs        []string
+	Certificate string // base64-encoded PEM chain
+}
+
+// GetAcmeCertificates lists the certificates stored in Traefik's ACME storage
+// on the Dokploy host, or on a remote server when serverID is set.
+func (c *DokployClient) GetAcmeCertificates(serverID string) ([]AcmeCertificate, error) {
+	content, err := c.ReadTraefikFile(TraefikAcmePath, serverID)
+	if err != nil {
+		return nil, err
+	}
+	if strings.TrimSpace(content) == "" {
+		return nil, nil
+	}
+
+	var storage map[string]struct {
+		Certificates []struct {
+			Domain struct {
+				Main string   `json:"main"`
+				SANs []string `json:"sans"`
+			} `json:"domain"`
+			Certificate string `json:"certificate"`
+		} `json:"Certificates"`
+	}
+	if err := json.Unmarshal([]byte(content), &storage); err != nil {
+		return nil, fmt.Errorf("failed to parse ACME storage: %w", err)
+	}
+
+	var certificates []AcmeCertificate
+	for resolver, account := range storage {
+		for _, cert := range account.Certificates {
+			certificates = append(certificates, AcmeCertificate{
+				Resolver:    resolver,
+				Main:        cert.Domain.Main,
+				SANs:        cert.Domain.SANs,
+				Certificate: cert.Certificate,
+			})
+		}
+	}
+	return certificates, nil
+}
+
+// ReadTraefikFile reads a file under the Traefik configuration directory of
+// the Dokploy host, or of a remote server when serverID is set.
+func (c *DokployClient) ReadTraefikFile(path, serverID string) (string, error) {
+	endpoint := fmt.Sprintf("settings.readTraefikFile?path=%s", url.QueryEscape(path))
+	if serverID != "" {
+		endpoint = fmt.Sprintf("%s&serverId=%s", endpoint, url.QueryEscape(serverID))
+	}
+	resp, err := c.doRequest("GET", endpoint, nil)
+	if err != nil {
+		return "", err
+	}
+
+	var content string
+	if err := json.Unmarshal(resp, &content); err != nil {
+		if string(resp) == "null" || string(resp) == "" {
+			return "", nil
+		}
+		return "", fmt.Errorf("failed to parse Traefik file response: %w", err)
+	}
+	return content, nil
+}
+
+// UpdateTraefikFile writes a file under the Traefik configuration directory
+// of the Dokploy host, or of a remote server when serverID is set.
+func (c *DokployClient) UpdateTraefikFile(path, content, serverID string) error {
+	payload := map[string]interface{}{
+		"path":          path,
+		"traefikConfig": content,
+	}
+	if serverID != "" {
+		payload["serverId"] = serverID
+	}
+	_, err := c.doRequest("POST", "settings.updateTraefikFile", payload)
+	return err
+}
+
+// --- Schedule ---
+
+// Schedule is a cron job that runs a command against an application, a
+// compose service, a remote server or the Dokploy host itself.
+type Schedule struct {
+	ScheduleID     string  `json:"scheduleId"`
+	Name           string  `json:"name"`
+	CronExpression string  `json:"cronExpression"`
+	AppName        string  `json:"appName"`
+	ServiceName    *string `json:"serviceName"`
+	ShellType      string  `json:"shellType"`
+	ScheduleType   string  `json:"scheduleType"`
+	Command        string  `json:"command"`
+	Script         *string `json:"script"`
+	ApplicationID  *string `json:"applicationId"`
+	ComposeID      *string `json:"composeId"`
+	ServerID       *string `json:"serverId"`
+	UserID         *string `json:"userId"`
+	Enabled        bool    `json:"enabled"`
+	Timezone       *string `json:"timezone"`
+	CreatedAt      string  `json:"createdAt"`
+}
+
+func schedulePayload(schedule Schedule) map[string]interface{} {
+	payload := map[string]interface{}{
+		"name":           schedule.Name,
+		"cronExpression": schedule.CronExpression,
+		"shellType":      schedule.ShellType,
+		"scheduleType":   schedule.ScheduleType,
+		"command":        schedule.Command,
+		"enabled":        schedule.Enabled,
+	}
+
+	if schedule.AppName != "" {
+		payload["appName"] = schedule.AppName
+	}
+	if schedule.ServiceName != nil {
+		payload["serviceName"] = *schedule.ServiceName
+	}
+	if schedule.Script != nil {
+		payload["script"] = *schedule.Script
+	}
+	if schedule.ApplicationID != nil {
+		payload["applicationId"] = *schedule.ApplicationID
+	}
+	if schedule.ComposeID != nil {
+		payload["composeId"] = *schedule.ComposeID
+	}
+	if schedule.ServerID != nil {
+		payload["serverId"] = *schedule.ServerID
+	}
+	if schedule.Timezone != nil {
+		payload["timezone"] = *schedule.Timezone
+	}
+	return payload
+}
+
+func (c *DokployClient) CreateSchedule(schedule Schedule) (*Schedule, error) {
+	resp, err := c.doRequest("POST", "schedule.create", schedulePayload(schedule))
+	if err != nil {
+		return nil, err
+	}
+
+	var result Schedule
+	if err := json.Unmarshal(resp, &result); err != nil {
+		return nil, err
+	}
+	return &result, nil
+}
+
+func (c *DokployClient) GetSchedule(id string) (*Schedule, error) {
+	endpoint := fmt.Sprintf("schedule.one?scheduleId=%s", id)
+	resp, err := c.doRequest("GET", endpoint, nil)
+	if err != nil {
+		return nil, err
+	}
+
+	var result Schedule
+	if err := json.Unmarshal(resp, &result); err != nil {
+		return nil, err
+	}
+	return &result, nil
+}
+
+func (c *DokployClient) UpdateSchedule(schedule Schedule) (*Schedule, error) {
+	payload := schedulePayload(schedule)
+	payload["scheduleId"] = schedule.ScheduleID
+
+	resp, err := c.doRequest("POST", "schedule.update", payload)
+	if err != nil {
+		return nil, err
+	}
+
+	var result Schedule
+	if err := json.Unmarshal(resp, &result); err != nil || result.ScheduleID == "" {
+		return c.GetSchedule(schedule.ScheduleID)
+	}
+	return &result, nil
+}
+
+func (c *DokployClient) DeleteSchedule(id string) error {
+	payload := map[string]string{
+		"scheduleId": id,
+	}
+	_, err := c.doRequest("POST", "schedule.delete", payload)
+	return err
+}
+
+// RunSchedule triggers an immediate run of a schedule outside its cron.
+func (c *DokployClient) RunSchedule(id string) error {
+	payload := map[string]string{
+		"scheduleId": id,
+	}
+	_, err := c.doRequest("POST", "schedule.runManually", payload)
+	return err
+}
+
+// ListSchedules lists schedules attached to an application, compose, server
+// or (with scheduleType "dokploy-server") the Dokploy host of a user.
+func (c *DokployClient) ListSchedules(id, scheduleType string) ([]Schedule, error) {
+	endpoint := fmt.Sprintf("schedule.list?id=%s&scheduleType=%s", id, scheduleType)
+	resp, err := c.doRequest("GET", endpoint, nil)
+	if err != nil {
+		return nil, err
+	}
+
+	var result []Schedule
+	if err := json.Unmarshal(resp, &result); err != nil {
+		return nil, err
+	}
+	return result, nil
+}
+
+// ListScheduleRuns retrieves the runs (deployments) of a schedule.
+func (c *DokployClient) ListScheduleRuns(scheduleID string) ([]Deployment, error) {
+	return c.ListDeployments(scheduleID, "schedule")
+}
+
+// --- Notification ---
+
+// Notification is a Dokploy notification channel. Exactly one of the
+// backend-specific objects is set, matching NotificationType.
+type Notification struct {
+	NotificationID   string `json:"notificationId"`
+	Name             string `json:"name"`
+	NotificationType string `json:"notificationType"`
+	AppDeploy        bool   `json:"appDeploy"`
+	AppBuildError    bool   `json:"appBuildError"`
+	DatabaseBackup   bool   `json:"databaseBackup"`
+	DockerCleanup    bool   `json:"dockerCleanup"`
+	ServerThreshold  bool   `json:"serverThreshold"`
+	DokployRestart   bool   `json:"dokployRestart"`
+	OrganizationID   string `json:"organizationId"`
+	CreatedAt        string `json:"createdAt"`
+
+	Slack    *SlackNotification    `json:"slack"`
+	Telegram *TelegramNotification `json:"telegram"`
+	Discord  *DiscordNotification  `json:"discord"`
+	Email    *EmailNotification    `json:"email"`
+	Gotify   *GotifyNotification   `json:"gotify"`
+	Ntfy     *NtfyNotification     `json:"ntfy"`
+	Custom   *CustomNotification   `json:"custom"`
+}
+
+type SlackNotification struct {
+	SlackID    string `json:"slackId"`
+	WebhookURL string `json:"webhookUrl"`
+	Channel    string `json:"channel"`
+}
+
+type TelegramNotification struct {
+	TelegramID      string `json:"telegramId"`
+	BotToken        string `json:"botToken"`
+	ChatID          string `json:"chatId"`
+	MessageThreadID string `json:"messageThreadId"`
+}
+
+type DiscordNotification struct {
+	DiscordID  string `json:"discordId"`
+	WebhookURL string `json:"webhookUrl"`
+	Decoration bool   `json:"decoration"`
+}
+
+type EmailNotification struct {
+	EmailID     string   `json:"emailId"`
+	SMTPServer  string   `json:"smtpServer"`
+	SMTPPort    int64    `json:"smtpPort"`
+	Username    string   `json:"username"`
+	Password    string   `json:"password"`
+	FromAddress string   `json:"fromAddress"`
+	ToAddresses []string `json:"toAddresses"`
+}
+
+type GotifyNotification struct {
+	GotifyID   string `json:"gotifyId"`
+	ServerURL  string `json:"serverUrl"`
+	AppToken   string `json:"appToken"`
+	Priority   int64  `json:"priority"`
+	Decoration bool   `json:"decoration"`
+}
+
+type NtfyNotification struct {
+	NtfyID      string `json:"ntfyId"`
+	ServerURL   string `json:"serverUrl"`
+	Topic       string `json:"topic"`
+	AccessToken string `json:"accessToken"`
+	Priority    int64  `json:"priority"`
+}
+
+// CustomNotification is a generic webhook that receives a JSON payload.
+type CustomNotification struct {
+	CustomID string            `json:"customId"`
+	Endpoint string            `json:"endpoint"`
+	Headers  map[string]string `json:"headers"`
+}
+
+// notificationEndpointNames maps a notification type to the suffix used by
+// its typed create, update and test endpoints.
+var notificationEndpointNames = map[string]string{
+	"slack":    "Slack",
+	"telegram": "Telegram",
+	"discord":  "Discord",
+	"email":    "Email",
+	"gotify":   "Gotify",
+	"ntfy":     "Ntfy",
+	"custom":   "Custom",
+}
+
+// backendID returns the ID of the backend-specific record, which the typed
+// update endpoints require alongside the notification ID.
+func (n *Notification) backendID() (string, string) {
+	switch n.NotificationType {
+	case "slack":
+		if n.Slack != nil {
+			return "slackId", n.Slack.SlackID
+		}
+	case "telegram":
+		if n.Telegram != nil {
+			return "telegramId", n.Telegram.TelegramID
+		}
+	case "discord":
+		if n.Discord != nil {
+			return "discordId", n.Discord.DiscordID
+		}
+	case "email":
+		if n.Email != nil {
+			return "emailId", n.Email.EmailID
+		}
+	case "gotify":
+		if n.Gotify != nil {
+			return "gotifyId", n.Gotify.GotifyID
+		}
+	case "ntfy":
+		if n.Ntfy != nil {
+			return "ntfyId", n.Ntfy.NtfyID
+		}
+	case "custom":
+		if n.Custom != nil {
+			return "customId", n.Custom.CustomID
+		}
+	}
+	return "", ""
+}
+
+func notificationEndpoint(action, notificationType string) (string, error) {
+	name, ok := notificationEndpointNames[notificationType]
+	if !ok {
+		return "", fmt.Errorf("unsupported notification type: %s", notificationType)
+	}
+	if action == "test" {
+		return fmt.Sprintf("notification.test%sConnection", name), nil
+	}
+	return fmt.Sprintf("notification.%s%s", action, name), nil
+}
+
+// CreateNotification creates a notification of the given type. The payload
+// holds the name, event flags and backend-specific fields.
+func (c *DokployClient) CreateNotification(notificationType string, payload map[string]interface{}) (*Notification, error) {
+	endpoint, err := notificationEndpoint("create", notificationType)
+	if err != nil {
+		return nil, err
+	}
+
+	resp, err := c.doRequest("POST", endpoint, payload)
+	if err != nil {
+		return nil, err
+	}
+
+	var result Notification
+	if err := json.Unmarshal(resp, &result); err == nil && result.NotificationID != "" {
+		return c.GetNotification(result.NotificationID)
+	}
+
+	// The create endpoints do not always return the new record, so look it
+	// up by name, preferring the most recently created match.
+	name, _ := payload["name"].(string)
+	notifications, err := c.ListNotifications()
+	if err != nil {
+		return nil, fmt.Errorf("notification created but failed to list notifications: %w", err)
+	}
+	var found *Notification
+	for i := range notifications {
+		n := &notifications[i]
+		if n.Name != name || n.NotificationType != notificationType {
+			continue
+		}
+		if found == nil || n.CreatedAt > found.CreatedAt {
+			found = n
+		}
+	}
+	if found == nil {
+		return nil, fmt.Errorf("notification created but not found in list by name: %s", name)
+	}
+	return c.GetNotification(found.NotificationID)
+}
+
+func (c *DokployClient) GetNotification(id string) (*Notification, error) {
+	endpoint := fmt.Sprintf("notification.one?notificationId=%s", id)
+	resp, err := c.doRequest("GET", endpoint, nil)
+	if err != nil {
+		return nil, err
+	}
+
+	var result Notification
+	if err := json.Unmarshal(resp, &result); err != nil {
+		return nil, err
+	}
+	return &result, nil
+}
+
+// UpdateNotification updates a notification. The backend-specific record ID
+// is looked up and added to the payload.
+func (c *DokployClient) UpdateNotification(id string, payload map[string]interface{}) (*Notification, error) {
+	existing, err := c.GetNotification(id)
+	if err != nil {
+		return nil, err
+	}
+
+	endpoint, err := notificationEndpoint("update", existing.NotificationType)
+	if err != nil {
+		return nil, err
+	}
+
+	idKey, backendID := existing.backendID()
+	if idKey == "" {
+		return nil, fmt.Errorf("notification %s has no %s configuration", id, existing.NotificationType)
+	}
+	payload["notificationId"] = id
+	payload[idKey] = backendID
+	if existing.OrganizationID != "" {
+		payload["organizationId"] = existing.OrganizationID
+	}
+
+	if _, err := c.doRequest("POST", endpoint, payload); err != nil {
+		return nil, err
+	}
+	return c.GetNotification(id)
+}
+
+func (c *DokployClient) DeleteNotification(id string) error {
+	payload := map[string]string{
+		"notificationId": id,
+	}
+	_, err := c.doRequest("POST", "notification.remove", payload)
+	return err
+}
+
+func (c *DokployClient) ListNotifications() ([]Notification, error) {
+	resp, err := c.doRequest("GET", "notification.all", nil)
+	if err != nil {
+		return nil, err
+	}
+
+	var result []Notification
+	if err := json.Unmarshal(resp, &result); err != nil {
+		return nil, err
+	}
+	return result, nil
+}
+
+// TestNotification sends a test message through an existing notification
+// using the test endpoint of its backend.
+func (c *DokployClient) TestNotification(id string) error {
+	n, err := c.GetNotification(id)
+	if err != nil {
+		return err
+	}
+
+	endpoint, err := notificationEndpoint("test", n.NotificationType)
+	if err != nil {
+		return err
+	}
+
+	var payload interface{}
+	switch {
+	case n.Slack != nil:
+		payload = map[string]interface{}{"webhookUrl": n.Slack.WebhookURL, "channel": n.Slack.Channel}
+	case n.Telegram != nil:
+		payload = map[string]interface{}{"botToken": n.Telegram.BotToken, "chatId": n.Telegram.ChatID, "messageThreadId": n.Telegram.MessageThreadID}
+	case n.Discord != nil:
+		payload = map[string]interface{}{"webhookUrl": n.Discord.WebhookURL, "decoration": n.Discord.Decoration}
+	case n.Email != nil:
+		payload = map[string]interface{}{
+			"smtpServer":  n.Email.SMTPServer,
+			"smtpPort":    n.Email.SMTPPort,
+			"username":    n.Email.Username,
+			"password":    n.Email.Password,
+			"fromAddress": n.Email.FromAddress,
+			"toAddresses": n.Email.ToAddresses,
+		}
+	case n.Gotify != nil:
+		payload = map[string]interface{}{"serverUrl": n.Gotify.ServerURL, "appToken": n.Gotify.AppToken, "priority": n.Gotify.Priority, "decoration": n.Gotify.Decoration}
+	case n.Ntfy != nil:
+		payload = map[string]interface{}{"serverUrl": n.Ntfy.ServerURL, "topic": n.Ntfy.Topic, "accessToken": n.Ntfy.AccessToken, "priority": n.Ntfy.Priority}
+	case n.Custom != nil:
+		payload = map[string]interface{}{"endpoint": n.Custom.Endpoint, "headers": n.Custom.Headers}
+	default:
+		return fmt.Errorf("notification %s has no %s configuration", id, n.NotificationType)
+	}
+
+	_, err = c.doRequest("POST", endpoint, payload)
+	return err
+}
+
 // --- Docker ---
 
 // DockerContainer represents a container from docker.getContainers.
@@ -0,0 +1,72 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/ahmedali6/terraform-provider-dokploy/internal/client"
+	"github.com/hashicorp/terraform-plugin-framework/action"
+	"github.com/hashicorp/terraform-plugin-framework/action/schema"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+var _ action.Action = &TestNotificationAction{}
+var _ action.ActionWithConfigure = &TestNotificationAction{}
+
+func NewTestNotificationAction() action.Action {
+	return &TestNotificationAction{}
+}
+
+// TestNotificationAction sends a test message through a notification channel.
+type TestNotificationAction struct {
+	client *client.DokployClient
+}
+
+type TestNotificationActionModel struct {
+	NotificationID types.String `tfsdk:"notification_id"`
+}
+
+func (a *TestNotificationAction) Metadata(_ context.Context, req action.MetadataRequest, resp *action.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_test_notification"
+}
+
+func (a *TestNotificationAction) Schema(_ context.Context, _ action.SchemaRequest, resp *action.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Description: "Sends a test message through a Dokploy notification channel using its test endpoint.",
+		Attributes: map[string]schema.Attribute{
+			"notification_id": schema.StringAttribute{
+				Required:    true,
+				Description: "The ID of the notification to test.",
+			},
+		},
+	}
+}
+
+func (a *TestNotificationAction) Configure(_ context.Context, req action.ConfigureRequest, resp *action.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+	client, ok := req.ProviderData.(*client.DokployClient)
+	if !ok {
+		resp.Diagnostics.AddError("Unexpected Action Configure Type", fmt.Sprintf("Expected *client.DokployClient, got: %T", req.ProviderData))
+		return
+	}
+	a.client = client
+}
+
+func (a *TestNotificationAction) Invoke(ctx context.Context, req action.InvokeRequest, resp *action.InvokeResponse) {
+	var config TestNotificationActionModel
+	resp.Diagnostics.Append(req.Config.Get(ctx, &config)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	resp.SendProgress(action.InvokeProgressEvent{
+		Message: fmt.Sprintf("Sending test message through notification %s", config.NotificationID.ValueString()),
+	})
+
+	if err := a.client.TestNotification(config.NotificationID.ValueString()); err != nil {
+		resp.Diagnostics.AddError("Error sending test notification", err.Error())
+		return
+	}
+}
@@ -0,0 +1,190 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+	"sort"
+	"strings"
+
+	"github.com/ahmedali6/terraform-provider-dokploy/internal/client"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/tfsdk"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"gopkg.in/yaml.v3"
+)
+
+// composeService describes a service of a compose file.
+type composeService struct {
+	Name    string
+	Ports   []composeServicePort
+	Volumes []string
+}
+
+// composeServicePort is a port a compose service publishes or exposes.
+// Published is empty for ports that are only exposed to other services.
+type composeServicePort struct {
+	Target    string
+	Published string
+	Protocol  string
+}
+
+// parseComposeServices returns the services of a compose file sorted by
+// name, along with the named volumes it declares.
+func parseComposeServices(content string) ([]composeService, []string, error) {
+	var file struct {
+		Services map[string]struct {
+			Ports   []interface{} `yaml:"ports"`
+			Expose  []interface{} `yaml:"expose"`
+			Volumes []interface{} `yaml:"volumes"`
+		} `yaml:"services"`
+		Volumes map[string]interface{} `yaml:"volumes"`
+	}
+	if err := yaml.Unmarshal([]byte(content), &file); err != nil {
+		return nil, nil, fmt.Errorf("failed to parse compose file: %w", err)
+	}
+
+	services := make([]composeService, 0, len(file.Services))
+	for name, svc := range file.Services {
+		service := composeService{Name: name}
+		for _, p := range svc.Ports {
+			service.Ports = append(service.Ports, parseComposePort(p))
+		}
+		for _, p := range svc.Expose {
+			port := parseComposePort(p)
+			port.Published = ""
+			service.Ports = append(service.Ports, port)
+		}
+		for _, v := range svc.Volumes {
+			if volume := composeNamedVolume(v); volume != "" {
+				service.Volumes = append(service.Volumes, volume)
+			}
+		}
+		services = append(services, service)
+	}
+	sort.Slice(services, func(i, j int) bool { return services[i].Name < services[j].Name })
+
+	volumes := make([]string, 0, len(file.Volumes))
+	for name := range file.Volumes {
+		volumes = append(volumes, name)
+	}
+	sort.Strings(volumes)
+	return services, volumes, nil
+}
+
+// parseComposePort reads a port in short ("[ip:][published:]target[/protocol]")
+// or long (map) syntax.
+func parseComposePort(v interface{}) composeServicePort {
+	port := composeServicePort{Protocol: "tcp"}
+	switch p := v.(type) {
+	case map[string]interface{}:
+		port.Target = fmt.Sprint(p["target"])
+		if published, ok := p["published"]; ok {
+			port.Published = fmt.Sprint(published)
+		}
+		if protocol, ok := p["protocol"]; ok {
+			port.Protocol = fmt.Sprint(protocol)
+		}
+	default:
+		spec := fmt.Sprint(p)
+		if base, protocol, ok := strings.Cut(spec, "/"); ok {
+			spec, port.Protocol = base, protocol
+		}
+		parts := strings.Split(spec, ":")
+		port.Target = parts[len(parts)-1]
+		if len(parts) > 1 {
+			port.Published = parts[len(parts)-2]
+		}
+	}
+	return port
+}
+
+// composeNamedVolume returns the named volume a service volume mounts, or ""
+// for bind mounts and anonymous volumes.
+func composeNamedVolume(v interface{}) string {
+	switch m := v.(type) {
+	case map[string]interface{}:
+		if m["type"] == "volume" && m["source"] != nil {
+			return fmt.Sprint(m["source"])
+		}
+	case string:
+		source, _, ok := strings.Cut(m, ":")
+		if ok && source != "" && !strings.ContainsAny(source[:1], "/.~$") {
+			return source
+		}
+	}
+	return ""
+}
+
+// loadComposeServiceNames returns the service names of a compose stack from
+// its stored compose file, or from Dokploy when the file lives in git.
+func loadComposeServiceNames(c *client.DokployClient, composeID string) ([]string, error) {
+	comp, err := c.GetCompose(composeID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to fetch compose %s: %w", composeID, err)
+	}
+	if strings.TrimSpace(comp.ComposeFile) == "" {
+		return c.LoadComposeServices(composeID)
+	}
+
+	services, _, err := parseComposeServices(comp.ComposeFile)
+	if err != nil {
+		return nil, err
+	}
+	names := make([]string, len(services))
+	for i, svc := range services {
+		names[i] = svc.Name
+	}
+	return names, nil
+}
+
+// checkComposeServiceName checks that serviceName is a service of the compose
+// stack. At plan time the stored compose file may be about to change in the
+// same apply, so an unknown service is only a warning; at apply time, after
+// the compose stack has been updated, the stored file is authoritative and
+// the check fails with an error on attrPath. Failures to load the services
+// only warn, as the stack may not have been cloned yet.
+func checkComposeServiceName(c *client.DokployClient, composeID, serviceName string, authoritative bool, attrPath path.Path, diags *diag.Diagnostics) {
+	names, err := loadComposeServiceNames(c, composeID)
+	if err != nil {
+		diags.AddAttributeWarning(attrPath, "Compose Service Check Failed", err.Error())
+		return
+	}
+	if len(names) == 0 {
+		return
+	}
+	for _, name := range names {
+		if name == serviceName {
+			return
+		}
+	}
+
+	detail := fmt.Sprintf("Service %q is not defined in compose %s. Available services: %s.", serviceName, composeID, strings.Join(names, ", "))
+	if !authoritative {
+		diags.AddAttributeWarning(
+			attrPath,
+			"Unknown Compose Service",
+			detail+" This is expected when the service is added to the compose file in the same apply; otherwise the apply will fail.",
+		)
+		return
+	}
+	diags.AddAttributeError(attrPath, "Unknown Compose Service", detail)
+}
+
+// checkComposeServiceNameOnApply repeats the service name check before a
+// create, or before an update that changes service_name. prior is nil on
+// create. By then the compose stack has been applied, so its stored compose
+// file is authoritative.
+func checkComposeServiceNameOnApply(ctx context.Context, c *client.DokployClient, composeID, serviceName types.String, prior *tfsdk.State, diags *diag.Diagnostics) {
+	if composeID.IsNull() || serviceName.IsNull() || serviceName.ValueString() == "" {
+		return
+	}
+	if prior != nil {
+		var priorName types.String
+		diags.Append(prior.GetAttribute(ctx, path.Root("service_name"), &priorName)...)
+		if diags.HasError() || priorName.Equal(serviceName) {
+			return
+		}
+	}
+	checkComposeServiceName(c, composeID.ValueString(), serviceName.ValueString(), true, path.Root("service_name"), diags)
+}
@@ -0,0 +1,177 @@
+package provider
+
+import (
+	"reflect"
+	"testing"
+)
+
+func TestParseComposePort(t *testing.T) {
+	tests := []struct {
+		name string
+		port interface{}
+		want composeServicePort
+	}{
+		{
+			name: "target only",
+			port: "80",
+			want: composeServicePort{Target: "80", Protocol: "tcp"},
+		},
+		{
+			name: "integer",
+			port: 80,
+			want: composeServicePort{Target: "80", Protocol: "tcp"},
+		},
+		{
+			name: "published and target",
+			port: "8080:80",
+			want: composeServicePort{Target: "80", Published: "8080", Protocol: "tcp"},
+		},
+		{
+			name: "host ip and protocol",
+			port: "127.0.0.1:5353:53/udp",
+			want: composeServicePort{Target: "53", Published: "5353", Protocol: "udp"},
+		},
+		{
+			name: "range",
+			port: "9090-9091:8080-8081",
+			want: composeServicePort{Target: "8080-8081", Published: "9090-9091", Protocol: "tcp"},
+		},
+		{
+			name: "long syntax",
+			port: map[string]interface{}{"target": 80, "published": "8080", "protocol": "udp", "mode": "host"},
+			want: composeServicePort{Target: "80", Published: "8080", Protocol: "udp"},
+		},
+		{
+			name: "long syntax target only",
+			port: map[string]interface{}{"target": 443},
+			want: composeServicePort{Target: "443", Protocol: "tcp"},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := parseComposePort(tt.port); got != tt.want {
+				t.Errorf("parseComposePort(%v) = %+v, want %+v", tt.port, got, tt.want)
+			}
+		})
+	}
+}
+
+func TestParseComposeServices(t *testing.T) {
+	tests := []struct {
+		name        string
+		content     string
+		wantService []composeService
+		wantVolumes []string
+	}{
+		{
+			name: "ports, expose and volumes",
+			content: `
+services:
+  web:
+    image: nginx
+    ports:
+      - "8080:80"
+      - target: 443
+        published: 8443
+    expose:
+      - "9000"
+    volumes:
+      - static:/usr/share/nginx/html
+      - ./nginx.conf:/etc/nginx/nginx.conf
+  db:
+    image: postgres
+    volumes:
+      - type: volume
+        source: data
+        target: /var/lib/postgresql/data
+volumes:
+  static: {}
+  data: {}
+`,
+			wantService: []composeService{
+				{Name: "db", Volumes: []string{"data"}},
+				{
+					Name: "web",
+					Ports: []composeServicePort{
+						{Target: "80", Published: "8080", Protocol: "tcp"},
+						{Target: "443", Published: "8443", Protocol: "tcp"},
+						{Target: "9000", Protocol: "tcp"},
+					},
+					Volumes: []string{"static"},
+				},
+			},
+			wantVolumes: []string{"data", "static"},
+		},
+		{
+			name: "include only",
+			content: `
+include:
+  - ./other/docker-compose.yml
+`,
+			wantService: []composeService{},
+			wantVolumes: []string{},
+		},
+		{
+			name: "x- extensions",
+			content: `
+x-logging: &logging
+  driver: json-file
+services:
+  web:
+    image: nginx
+    logging: *logging
+`,
+			wantService: []composeService{{Name: "web"}},
+			wantVolumes: []string{},
+		},
+		{
+			name: "env-interpolated volume source",
+			content: `
+services:
+  web:
+    image: nginx
+    volumes:
+      - ${DATA_DIR}:/data
+      - ~/cache:/cache
+`,
+			wantService: []composeService{{Name: "web"}},
+			wantVolumes: []string{},
+		},
+		{
+			name: "external network",
+			content: `
+services:
+  web:
+    image: nginx
+    networks: [dokploy-network]
+networks:
+  dokploy-network:
+    external: true
+`,
+			wantService: []composeService{{Name: "web"}},
+			wantVolumes: []string{},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			services, volumes, err := parseComposeServices(tt.content)
+			if err != nil {
+				t.Fatalf("parseComposeServices() error = %v", err)
+			}
+			if !reflect.DeepEqual(services, tt.wantService) {
+				t.Errorf("parseComposeServices() services = %+v, want %+v", services, tt.wantService)
+			}
+			if !reflect.DeepEqual(volumes, tt.wantVolumes) {
+				t.Errorf("parseComposeServices() volumes = %q, want %q", volumes, tt.wantVolumes)
+			}
+		})
+	}
+}
+
+func TestParseComposeServicesInvalid(t *testing.T) {
+	if _, _, err := parseComposeServices("services: [web"); err == nil {
+		t.Error("parseComposeServices() error = nil, want a parse error")
+	}
+}
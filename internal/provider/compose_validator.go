@@ -0,0 +1,191 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+	"sort"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"gopkg.in/yaml.v3"
+)
+
+var composeTopLevelKeys = map[string]bool{
+	"version": true, "name": true, "include": true, "services": true,
+	"networks": true, "volumes": true, "configs": true, "secrets": true, "models": true,
+}
+
+// composeServiceKeys lists the service attributes of the Compose
+// Specification.
+var composeServiceKeys = map[string]bool{
+	"annotations": true, "attach": true, "blkio_config": true, "build": true,
+	"cap_add": true, "cap_drop": true, "cgroup": true, "cgroup_parent": true,
+	"command": true, "configs": true, "container_name": true, "cpu_count": true,
+	"cpu_percent": true, "cpu_period": true, "cpu_quota": true, "cpu_rt_period": true,
+	"cpu_rt_runtime": true, "cpu_shares": true, "cpus": true, "cpuset": true,
+	"credential_spec": true, "depends_on": true, "deploy": true, "develop": true,
+	"device_cgroup_rules": true, "devices": true, "dns": true, "dns_opt": true,
+	"dns_search": true, "domainname": true, "driver_opts": true, "entrypoint": true,
+	"env_file": true, "environment": true, "expose": true, "extends": true,
+	"external_links": true, "extra_hosts": true, "gpus": true, "group_add": true,
+	"healthcheck": true, "hostname": true, "image": true, "init": true,
+	"ipc": true, "isolation": true, "label_file": true, "labels": true,
+	"links": true, "logging": true, "mac_address": true, "mem_limit": true,
+	"mem_reservation": true, "mem_swappiness": true, "memswap_limit": true, "models": true,
+	"network_mode": true, "networks": true, "oom_kill_disable": true, "oom_score_adj": true,
+	"pid": true, "pids_limit": true, "platform": true, "ports": true,
+	"post_start": true, "pre_stop": true, "privileged": true, "profiles": true,
+	"provider": true, "pull_policy": true, "pull_refresh_after": true, "read_only": true,
+	"restart": true, "runtime": true, "scale": true, "secrets": true,
+	"security_opt": true, "shm_size": true, "stdin_open": true, "stop_grace_period": true,
+	"stop_signal": true, "storage_opt": true, "sysctls": true, "tmpfs": true,
+	"tty": true, "ulimits": true, "use_api_socket": true, "user": true,
+	"userns_mode": true, "uts": true, "volumes": true, "volumes_from": true,
+	"working_dir": true,
+}
+
+// validateComposeFile checks a compose file against the structure of the
+// Compose Specification and for references to undeclared services, volumes
+// and networks. It returns every problem found.
+func validateComposeFile(content string) []string {
+	// Empty content is treated like an unset compose file.
+	if strings.TrimSpace(content) == "" {
+		return nil
+	}
+
+	// Syntax errors are reported by the YAML type.
+	var document interface{}
+	if err := yaml.Unmarshal([]byte(content), &document); err != nil {
+		return nil
+	}
+	if document == nil {
+		return []string{"the file is empty"}
+	}
+	file, ok := document.(map[string]interface{})
+	if !ok {
+		return []string{"the file must be a mapping"}
+	}
+
+	var problems []string
+	for _, key := range sortedKeys(file) {
+		if !composeTopLevelKeys[key] && !strings.HasPrefix(key, "x-") {
+			problems = append(problems, fmt.Sprintf("unknown top-level key %q", key))
+		}
+	}
+
+	rawServices, ok := file["services"]
+	if !ok {
+		if _, hasInclude := file["include"]; !hasInclude {
+			problems = append(problems, "services is required")
+		}
+		return problems
+	}
+	services, ok := rawServices.(map[string]interface{})
+	if !ok {
+		return append(problems, "services must be a mapping of service names to definitions")
+	}
+	volumes := declaredNames(file["volumes"])
+	networks := declaredNames(file["networks"])
+	networks["default"] = true
+
+	for _, name := range sortedKeys(services) {
+		service, ok := services[name].(map[string]interface{})
+		if !ok {
+			problems = append(problems, fmt.Sprintf("service %q must be a mapping", name))
+			continue
+		}
+		for _, key := range sortedKeys(service) {
+			if !composeServiceKeys[key] && !strings.HasPrefix(key, "x-") {
+				problems = append(problems, fmt.Sprintf("service %q has unknown key %q", name, key))
+			}
+		}
+		_, hasImage := service["image"]
+		_, hasBuild := service["build"]
+		_, hasExtends := service["extends"]
+		if !hasImage && !hasBuild && !hasExtends {
+			problems = append(problems, fmt.Sprintf("service %q must define image, build or extends", name))
+		}
+		for _, dep := range referencedNames(service["depends_on"]) {
+			if _, ok := services[dep]; !ok {
+				problems = append(problems, fmt.Sprintf("service %q depends on undefined service %q", name, dep))
+			}
+		}
+		for _, network := range referencedNames(service["networks"]) {
+			if !networks[network] {
+				problems = append(problems, fmt.Sprintf("service %q uses undeclared network %q", name, network))
+			}
+		}
+		if list, ok := service["volumes"].([]interface{}); ok {
+			for _, v := range list {
+				if volume := composeNamedVolume(v); volume != "" && !volumes[volume] {
+					problems = append(problems, fmt.Sprintf("service %q uses undeclared volume %q", name, volume))
+				}
+			}
+		}
+	}
+	return problems
+}
+
+// referencedNames returns the names of a list or mapping of references, such
+// as depends_on or networks.
+func referencedNames(v interface{}) []string {
+	var names []string
+	switch refs := v.(type) {
+	case []interface{}:
+		for _, ref := range refs {
+			names = append(names, fmt.Sprint(ref))
+		}
+	case map[string]interface{}:
+		names = sortedKeys(refs)
+	}
+	return names
+}
+
+// declaredNames returns the keys of a top-level volumes or networks mapping.
+func declaredNames(v interface{}) map[string]bool {
+	names := map[string]bool{}
+	if m, ok := v.(map[string]interface{}); ok {
+		for name := range m {
+			names[name] = true
+		}
+	}
+	return names
+}
+
+func sortedKeys(m map[string]interface{}) []string {
+	keys := make([]string, 0, len(m))
+	for k := range m {
+		keys = append(keys, k)
+	}
+	sort.Strings(keys)
+	return keys
+}
+
+// composeFileValidator validates compose file content at plan time.
+func composeFileValidator() validator.String {
+	return composeValidator{}
+}
+
+type composeValidator struct{}
+
+func (v composeValidator) Description(_ context.Context) string {
+	return "value must be a valid compose file"
+}
+
+func (v composeValidator) MarkdownDescription(ctx context.Context) string {
+	return v.Description(ctx)
+}
+
+func (v composeValidator) ValidateString(_ context.Context, req validator.StringRequest, resp *validator.StringResponse) {
+	if req.ConfigValue.IsNull() || req.ConfigValue.IsUnknown() {
+		return
+	}
+
+	if problems := validateComposeFile(req.ConfigValue.ValueString()); len(problems) > 0 {
+		resp.Diagnostics.AddAttributeError(
+			req.Path,
+			"Invalid Compose File",
+			fmt.Sprintf("Compose file is not valid: %s.", strings.Join(problems, "; ")),
+		)
+	}
+}
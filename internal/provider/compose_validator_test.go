@@ -0,0 +1,165 @@
+package provider
+
+import (
+	"reflect"
+	"testing"
+)
+
+func TestValidateComposeFile(t *testing.T) {
+	tests := []struct {
+		name    string
+		content string
+		want    []string
+	}{
+		{
+			name:    "empty",
+			content: "",
+		},
+		{
+			name:    "whitespace only",
+			content: "  \n\t\n",
+		},
+		{
+			name: "valid",
+			content: `
+services:
+  web:
+    image: nginx
+    depends_on: [db]
+    volumes:
+      - data:/usr/share/nginx/html
+  db:
+    image: postgres
+volumes:
+  data: {}
+`,
+		},
+		{
+			name: "include only",
+			content: `
+include:
+  - ./other/docker-compose.yml
+`,
+		},
+		{
+			name: "x- extensions",
+			content: `
+x-common: &common
+  restart: always
+services:
+  web:
+    <<: *common
+    image: nginx
+    x-dokploy: true
+`,
+		},
+		{
+			name: "env-interpolated volume source",
+			content: `
+services:
+  web:
+    image: nginx
+    volumes:
+      - ${DATA_DIR:-./data}:/data
+      - ./config:/etc/nginx
+      - /var/run/docker.sock:/var/run/docker.sock
+`,
+		},
+		{
+			name: "external network",
+			content: `
+services:
+  web:
+    image: nginx
+    networks:
+      - dokploy-network
+networks:
+  dokploy-network:
+    external: true
+`,
+		},
+		{
+			name: "default network",
+			content: `
+services:
+  web:
+    image: nginx
+    networks: [default]
+`,
+		},
+		{
+			name:    "empty document",
+			content: "# no content\n",
+			want:    []string{"the file is empty"},
+		},
+		{
+			name:    "not a mapping",
+			content: "- web\n",
+			want:    []string{"the file must be a mapping"},
+		},
+		{
+			name:    "missing services",
+			content: "volumes:\n  data: {}\n",
+			want:    []string{"services is required"},
+		},
+		{
+			name:    "services not a mapping",
+			content: "services:\n  - web\n",
+			want:    []string{"services must be a mapping of service names to definitions"},
+		},
+		{
+			name: "unknown keys",
+			content: `
+service:
+  web: {}
+services:
+  web:
+    image: nginx
+    imag: nginx
+`,
+			want: []string{
+				`unknown top-level key "service"`,
+				`service "web" has unknown key "imag"`,
+			},
+		},
+		{
+			name: "service without image",
+			content: `
+services:
+  web:
+    ports: ["80:80"]
+`,
+			want: []string{`service "web" must define image, build or extends`},
+		},
+		{
+			name: "undefined references",
+			content: `
+services:
+  web:
+    image: nginx
+    depends_on:
+      db:
+        condition: service_healthy
+    networks: [backend]
+    volumes:
+      - type: volume
+        source: data
+        target: /data
+`,
+			want: []string{
+				`service "web" depends on undefined service "db"`,
+				`service "web" uses undeclared network "backend"`,
+				`service "web" uses undeclared volume "data"`,
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got := validateComposeFile(tt.content)
+			if !reflect.DeepEqual(got, tt.want) {
+				t.Errorf("validateComposeFile() = %q, want %q", got, tt.want)
+			}
+		})
+	}
+}
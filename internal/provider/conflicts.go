@@ -0,0 +1,110 @@
+package provider
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/ahmedali6/terraform-provider-dokploy/internal/client"
+)
+
+// findDomainConflict looks for an existing domain, other than excludeID,
+// routing the same host and path. Hosts are compared across all servers, as
+// a host name can only resolve to one of them. It returns a description of
+// the owning domain, or "" when there is none.
+func findDomainConflict(c *client.DokployClient, host, routePath, excludeID string) (string, error) {
+	matches := func(d client.Domain) bool {
+		return d.ID != excludeID && strings.EqualFold(d.Host, host) && normalizeRoutePath(d.Path) == normalizeRoutePath(routePath)
+	}
+
+	projects, err := c.ListProjects()
+	if err != nil {
+		return "", fmt.Errorf("failed to list projects: %w", err)
+	}
+	for _, proj := range projects {
+		for _, env := range proj.Environments {
+			for _, app := range env.Applications {
+				// Domains are nested in the project tree; look them up only
+				// when the tree leaves them out.
+				domains := app.Domains
+				if domains == nil {
+					domains, err = c.GetDomainsByApplication(app.ID)
+					if err != nil {
+						return "", fmt.Errorf("failed to list domains of application %s: %w", app.ID, err)
+					}
+				}
+				for _, d := range domains {
+					if matches(d) {
+						return fmt.Sprintf("domain %s of application %q (%s)", d.ID, app.Name, app.ID), nil
+					}
+				}
+			}
+			for _, comp := range env.Compose {
+				domains := comp.Domains
+				if domains == nil {
+					domains, err = c.GetDomainsByCompose(comp.ID)
+					if err != nil {
+						return "", fmt.Errorf("failed to list domains of compose %s: %w", comp.ID, err)
+					}
+				}
+				for _, d := range domains {
+					if matches(d) {
+						return fmt.Sprintf("domain %s of compose %q (%s)", d.ID, comp.Name, comp.ID), nil
+					}
+				}
+			}
+		}
+	}
+	return "", nil
+}
+
+// findPortConflict looks for an existing port mapping, other than excludeID,
+// publishing the same port and protocol on the server hosting applicationID.
+// It returns a description of the owning port, or "" when there is none.
+func findPortConflict(c *client.DokployClient, applicationID string, publishedPort int64, protocol, excludeID string) (string, error) {
+	projects, err := c.ListProjects()
+	if err != nil {
+		return "", fmt.Errorf("failed to list projects: %w", err)
+	}
+
+	var apps []client.Application
+	var target *client.Application
+	for _, proj := range projects {
+		for _, env := range proj.Environments {
+			for i, app := range env.Applications {
+				apps = append(apps, app)
+				if app.ID == applicationID {
+					target = &env.Applications[i]
+				}
+			}
+		}
+	}
+	if target == nil {
+		return "", fmt.Errorf("application %s not found", applicationID)
+	}
+
+	for _, app := range apps {
+		// Only ports on the same server collide.
+		if app.ServerID != target.ServerID {
+			continue
+		}
+		ports, err := c.GetPortsByApplication(app.ID)
+		if err != nil {
+			return "", fmt.Errorf("failed to list ports of application %s: %w", app.ID, err)
+		}
+		for _, p := range ports {
+			if p.ID == excludeID || p.PublishedPort != publishedPort || !strings.EqualFold(p.Protocol, protocol) {
+				continue
+			}
+			return fmt.Sprintf("port %s of application %q (%s)", p.ID, app.Name, app.ID), nil
+		}
+	}
+	return "", nil
+}
+
+// normalizeRoutePath treats an empty path as the root path.
+func normalizeRoutePath(p string) string {
+	if p == "" {
+		return "/"
+	}
+	return p
+}
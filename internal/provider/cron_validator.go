@@ -0,0 +1,156 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+	"strconv"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+)
+
+// cronField describes the accepted values of one cron expression field.
+type cronField struct {
+	name  string
+	min   int
+	max   int
+	names map[string]int
+}
+
+var (
+	cronSeconds = cronField{name: "second", min: 0, max: 59}
+	cronMinutes = cronField{name: "minute", min: 0, max: 59}
+	cronHours   = cronField{name: "hour", min: 0, max: 23}
+	cronDays    = cronField{name: "day of month", min: 1, max: 31}
+	cronMonths  = cronField{name: "month", min: 1, max: 12, names: map[string]int{
+		"JAN": 1, "FEB": 2, "MAR": 3, "APR": 4, "MAY": 5, "JUN": 6,
+		"JUL": 7, "AUG": 8, "SEP": 9, "OCT": 10, "NOV": 11, "DEC": 12,
+	}}
+	// Both 0 and 7 mean Sunday.
+	cronWeekdays = cronField{name: "day of week", min: 0, max: 7, names: map[string]int{
+		"SUN": 0, "MON": 1, "TUE": 2, "WED": 3, "THU": 4, "FRI": 5, "SAT": 6,
+	}}
+)
+
+var cronMacros = map[string]bool{
+	"@yearly": true, "@annually": true, "@monthly": true, "@weekly": true,
+	"@daily": true, "@midnight": true, "@hourly": true,
+}
+
+// parseCronExpression checks a standard five-field cron expression, or a
+// six-field one with a leading seconds field, as accepted by Dokploy.
+func parseCronExpression(expr string) error {
+	expr = strings.TrimSpace(expr)
+	if strings.HasPrefix(expr, "@") {
+		if !cronMacros[strings.ToLower(expr)] {
+			return fmt.Errorf("unknown macro %q", expr)
+		}
+		return nil
+	}
+
+	parts := strings.Fields(expr)
+	var fields []cronField
+	switch len(parts) {
+	case 5:
+		fields = []cronField{cronMinutes, cronHours, cronDays, cronMonths, cronWeekdays}
+	case 6:
+		fields = []cronField{cronSeconds, cronMinutes, cronHours, cronDays, cronMonths, cronWeekdays}
+	default:
+		return fmt.Errorf("expected 5 or 6 fields, got %d", len(parts))
+	}
+
+	for i, part := range parts {
+		if err := fields[i].parse(part); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func (f cronField) parse(s string) error {
+	for _, item := range strings.Split(s, ",") {
+		if err := f.parseItem(item); err != nil {
+			return fmt.Errorf("invalid %s field %q: %w", f.name, s, err)
+		}
+	}
+	return nil
+}
+
+func (f cronField) parseItem(item string) error {
+	rangePart, stepPart, hasStep := strings.Cut(item, "/")
+	if hasStep {
+		step, err := strconv.Atoi(stepPart)
+		if err != nil || step < 1 {
+			return fmt.Errorf("step %q must be a positive integer", stepPart)
+		}
+	}
+
+	if rangePart == "*" || (rangePart == "?" && !hasStep && f.allowsAny()) {
+		return nil
+	}
+
+	lowPart, highPart, isRange := strings.Cut(rangePart, "-")
+	low, err := f.value(lowPart)
+	if err != nil {
+		return err
+	}
+	if !isRange {
+		return nil
+	}
+	high, err := f.value(highPart)
+	if err != nil {
+		return err
+	}
+	if low > high {
+		return fmt.Errorf("range %q is reversed", rangePart)
+	}
+	return nil
+}
+
+// allowsAny reports whether "?" ("no specific value") is accepted.
+func (f cronField) allowsAny() bool {
+	return f.name == cronDays.name || f.name == cronWeekdays.name
+}
+
+func (f cronField) value(s string) (int, error) {
+	if v, ok := f.names[strings.ToUpper(s)]; ok {
+		return v, nil
+	}
+	v, err := strconv.Atoi(s)
+	if err != nil {
+		return 0, fmt.Errorf("%q is not a number", s)
+	}
+	if v < f.min || v > f.max {
+		return 0, fmt.Errorf("%d is outside %d-%d", v, f.min, f.max)
+	}
+	return v, nil
+}
+
+// cronExpressionValidator validates cron expressions at plan time.
+func cronExpressionValidator() validator.String {
+	return cronValidator{}
+}
+
+type cronValidator struct{}
+
+func (v cronValidator) Description(_ context.Context) string {
+	return "value must be a valid cron expression"
+}
+
+func (v cronValidator) MarkdownDescription(ctx context.Context) string {
+	return v.Description(ctx)
+}
+
+func (v cronValidator) ValidateString(_ context.Context, req validator.StringRequest, resp *validator.StringResponse) {
+	if req.ConfigValue.IsNull() || req.ConfigValue.IsUnknown() {
+		return
+	}
+
+	if err := parseCronExpression(req.ConfigValue.ValueString()); err != nil {
+		resp.Diagnostics.AddAttributeError(
+			req.Path,
+			"Invalid Cron Expression",
+			fmt.Sprintf("%q is not a valid cron expression: %s.", req.ConfigValue.ValueString(), err),
+		)
+	}
+}
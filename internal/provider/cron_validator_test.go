@@ -0,0 +1,132 @@
+package provider
+
+import (
+	"context"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+func TestParseCronExpression(t *testing.T) {
+	tests := []struct {
+		expr    string
+		wantErr bool
+	}{
+		// Macros
+		{expr: "@daily"},
+		{expr: "@yearly"},
+		{expr: "@annually"},
+		{expr: "@monthly"},
+		{expr: "@weekly"},
+		{expr: "@midnight"},
+		{expr: "@hourly"},
+		{expr: "@Daily"},
+		{expr: "  @hourly  "},
+		{expr: "@every", wantErr: true},
+		{expr: "@reboot", wantErr: true},
+
+		// Field count
+		{expr: "* * * * *"},
+		{expr: "0 * * * * *"},
+		{expr: "", wantErr: true},
+		{expr: "* * * *", wantErr: true},
+		{expr: "* * * * * * *", wantErr: true},
+
+		// Five fields
+		{expr: "0 3 * * *"},
+		{expr: "30 2 1 1 0"},
+		{expr: "59 23 31 12 7"},
+		{expr: "0,15,30,45 * * * *"},
+		{expr: "0 9-17 * * 1-5"},
+
+		// Six fields
+		{expr: "30 0 3 * * *"},
+		{expr: "59 59 23 31 12 7"},
+		{expr: "60 * * * * *", wantErr: true},
+
+		// Month and day names
+		{expr: "0 0 1 JAN *"},
+		{expr: "0 0 1 jan-jun *"},
+		{expr: "0 0 * * MON-FRI"},
+		{expr: "0 0 * * sun,sat"},
+		{expr: "0 0 1 JANUARY *", wantErr: true},
+		{expr: "0 0 * MON *", wantErr: true},
+		{expr: "0 0 JAN * *", wantErr: true},
+
+		// "?" is only allowed in the day fields
+		{expr: "0 0 ? * MON"},
+		{expr: "0 0 1 * ?"},
+		{expr: "0 0 0 ? * MON"},
+		{expr: "? * * * *", wantErr: true},
+		{expr: "0 ? * * *", wantErr: true},
+		{expr: "0 0 * ? *", wantErr: true},
+		{expr: "0 0 ?/2 * *", wantErr: true},
+
+		// Steps
+		{expr: "*/5 * * * *"},
+		{expr: "0-30/10 * * * *"},
+		{expr: "5/15 * * * *"},
+		{expr: "*/0 * * * *", wantErr: true},
+		{expr: "*/-1 * * * *", wantErr: true},
+		{expr: "*/x * * * *", wantErr: true},
+		{expr: "*/ * * * *", wantErr: true},
+
+		// Ranges
+		{expr: "0 0 1-15 * *"},
+		{expr: "0 0 5-5 * *"},
+		{expr: "30-10 * * * *", wantErr: true},
+		{expr: "0 0 * DEC-JAN *", wantErr: true},
+		{expr: "0 0 * * FRI-MON", wantErr: true},
+
+		// Out of range values
+		{expr: "60 * * * *", wantErr: true},
+		{expr: "-1 * * * *", wantErr: true},
+		{expr: "0 24 * * *", wantErr: true},
+		{expr: "0 0 0 * *", wantErr: true},
+		{expr: "0 0 32 * *", wantErr: true},
+		{expr: "0 0 * 0 *", wantErr: true},
+		{expr: "0 0 * 13 *", wantErr: true},
+		{expr: "0 0 * * 8", wantErr: true},
+		{expr: "0 0-24 * * *", wantErr: true},
+
+		// Malformed items
+		{expr: "a * * * *", wantErr: true},
+		{expr: "1,,2 * * * *", wantErr: true},
+		{expr: "0 0 1- * *", wantErr: true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.expr, func(t *testing.T) {
+			err := parseCronExpression(tt.expr)
+			if (err != nil) != tt.wantErr {
+				t.Errorf("parseCronExpression(%q) error = %v, wantErr %v", tt.expr, err, tt.wantErr)
+			}
+		})
+	}
+}
+
+func TestCronExpressionValidator(t *testing.T) {
+	tests := []struct {
+		name    string
+		value   types.String
+		wantErr bool
+	}{
+		{name: "null", value: types.StringNull()},
+		{name: "unknown", value: types.StringUnknown()},
+		{name: "valid", value: types.StringValue("0 3 * * *")},
+		{name: "invalid", value: types.StringValue("*/0 * * * *"), wantErr: true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			req := validator.StringRequest{Path: path.Root("cron_expression"), ConfigValue: tt.value}
+			var resp validator.StringResponse
+			cronExpressionValidator().ValidateString(context.Background(), req, &resp)
+			if resp.Diagnostics.HasError() != tt.wantErr {
+				t.Errorf("ValidateString() diagnostics = %v, wantErr %v", resp.Diagnostics, tt.wantErr)
+			}
+		})
+	}
+}
@@ -0,0 +1,78 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/ahmedali6/terraform-provider-dokploy/internal/client"
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+var _ datasource.DataSource = &ComposeConvertedDataSource{}
+
+func NewComposeConvertedDataSource() datasource.DataSource {
+	return &ComposeConvertedDataSource{}
+}
+
+type ComposeConvertedDataSource struct {
+	client *client.DokployClient
+}
+
+type ComposeConvertedDataSourceModel struct {
+	ComposeID          types.String `tfsdk:"compose_id"`
+	ComposeFileContent types.String `tfsdk:"compose_file_content"`
+}
+
+func (d *ComposeConvertedDataSource) Metadata(_ context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_compose_converted"
+}
+
+func (d *ComposeConvertedDataSource) Schema(_ context.Context, _ datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Description: "Fetches the compose file Dokploy deploys for a compose stack, after applying the suffix, " +
+			"randomization, isolated deployment network and Traefik labels for its domains.",
+		Attributes: map[string]schema.Attribute{
+			"compose_id": schema.StringAttribute{
+				Required:    true,
+				Description: "ID of the compose stack.",
+			},
+			"compose_file_content": schema.StringAttribute{
+				Computed:    true,
+				Description: "The converted compose file.",
+			},
+		},
+	}
+}
+
+func (d *ComposeConvertedDataSource) Configure(_ context.Context, req datasource.ConfigureRequest, resp *datasource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+	c, ok := req.ProviderData.(*client.DokployClient)
+	if !ok {
+		resp.Diagnostics.AddError("Unexpected Data Source Configure Type", fmt.Sprintf("Expected *client.DokployClient, got: %T", req.ProviderData))
+		return
+	}
+	d.client = c
+}
+
+func (d *ComposeConvertedDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	var data ComposeConvertedDataSourceModel
+	diags := req.Config.Get(ctx, &data)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	content, err := d.client.GetConvertedCompose(data.ComposeID.ValueString())
+	if err != nil {
+		resp.Diagnostics.AddError("Unable to Read Converted Compose", err.Error())
+		return
+	}
+	data.ComposeFileContent = types.StringValue(content)
+
+	diags = resp.State.Set(ctx, &data)
+	resp.Diagnostics.Append(diags...)
+}
@@ -0,0 +1,69 @@
+package provider
+
+import (
+	"fmt"
+	"os"
+	"regexp"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+)
+
+func TestAccComposeConvertedDataSource(t *testing.T) {
+	host := os.Getenv("DOKPLOY_HOST")
+	apiKey := os.Getenv("DOKPLOY_API_KEY")
+
+	if host == "" || apiKey == "" {
+		t.Skip("DOKPLOY_HOST and DOKPLOY_API_KEY must be set for acceptance tests")
+	}
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccComposeConvertedDataSourceConfig(),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					// The suffix is appended to service names
+					resource.TestMatchResourceAttr("data.dokploy_compose_converted.test", "compose_file_content", regexp.MustCompile(`web-tfacc`)),
+				),
+			},
+		},
+	})
+}
+
+func testAccComposeConvertedDataSourceConfig() string {
+	return fmt.Sprintf(`
+provider "dokploy" {
+  host    = "%s"
+  api_key = "%s"
+}
+
+resource "dokploy_project" "test" {
+  name        = "test-compose-converted-project"
+  description = "Test project for converted compose data source"
+}
+
+resource "dokploy_environment" "test" {
+  project_id = dokploy_project.test.id
+  name       = "test-compose-converted-env"
+}
+
+resource "dokploy_compose" "test" {
+  environment_id       = dokploy_environment.test.id
+  name                 = "test-compose-converted"
+  source_type          = "raw"
+  randomize            = true
+  suffix               = "tfacc"
+  compose_file_content = <<EOF
+services:
+  web:
+    image: nginx:latest
+EOF
+}
+
+data "dokploy_compose_converted" "test" {
+  compose_id = dokploy_compose.test.id
+}
+`, os.Getenv("DOKPLOY_HOST"), os.Getenv("DOKPLOY_API_KEY"))
+}
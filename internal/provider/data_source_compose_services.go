@@ -0,0 +1,206 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	"github.com/ahmedali6/terraform-provider-dokploy/internal/client"
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+var _ datasource.DataSource = &ComposeServicesDataSource{}
+
+func NewComposeServicesDataSource() datasource.DataSource {
+	return &ComposeServicesDataSource{}
+}
+
+type ComposeServicesDataSource struct {
+	client *client.DokployClient
+}
+
+type ComposeServicesDataSourceModel struct {
+	// Source - exactly one must be specified
+	ComposeID          types.String `tfsdk:"compose_id"`
+	ComposeFileContent types.String `tfsdk:"compose_file_content"`
+
+	// Results
+	ServiceNames []types.String            `tfsdk:"service_names"`
+	Services     []ComposeServiceDataModel `tfsdk:"services"`
+	Volumes      []types.String            `tfsdk:"volumes"`
+}
+
+type ComposeServiceDataModel struct {
+	Name    types.String                  `tfsdk:"name"`
+	Ports   []ComposeServicePortDataModel `tfsdk:"ports"`
+	Volumes []types.String                `tfsdk:"volumes"`
+}
+
+type ComposeServicePortDataModel struct {
+	Target    types.String `tfsdk:"target"`
+	Published types.String `tfsdk:"published"`
+	Protocol  types.String `tfsdk:"protocol"`
+}
+
+func (d *ComposeServicesDataSource) Metadata(_ context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_compose_services"
+}
+
+func (d *ComposeServicesDataSource) Schema(_ context.Context, _ datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Description: "Lists the services of a compose stack with their ports and named volumes. " +
+			"For stacks sourced from git, only service names are available, as loaded by Dokploy from the last clone.",
+		Attributes: map[string]schema.Attribute{
+			"compose_id": schema.StringAttribute{
+				Optional:    true,
+				Description: "ID of the compose stack to inspect. Exactly one of compose_id or compose_file_content must be specified.",
+				Validators: []validator.String{
+					stringvalidator.ExactlyOneOf(path.MatchRoot("compose_file_content")),
+				},
+			},
+			"compose_file_content": schema.StringAttribute{
+				Optional:    true,
+				Description: "Compose file content to parse instead of loading a stack from Dokploy.",
+				Validators: []validator.String{
+					composeFileValidator(),
+				},
+			},
+			"service_names": schema.ListAttribute{
+				Computed:    true,
+				ElementType: types.StringType,
+				Description: "Names of the services, sorted.",
+			},
+			"services": schema.ListNestedAttribute{
+				Computed:    true,
+				Description: "The services, sorted by name.",
+				NestedObject: schema.NestedAttributeObject{
+					Attributes: map[string]schema.Attribute{
+						"name": schema.StringAttribute{
+							Computed:    true,
+							Description: "Service name.",
+						},
+						"ports": schema.ListNestedAttribute{
+							Computed:    true,
+							Description: "Ports the service publishes or exposes.",
+							NestedObject: schema.NestedAttributeObject{
+								Attributes: map[string]schema.Attribute{
+									"target": schema.StringAttribute{
+										Computed:    true,
+										Description: "Container port or port range.",
+									},
+									"published": schema.StringAttribute{
+										Computed:    true,
+										Description: "Host port or port range. Null for ports that are only exposed to other services.",
+									},
+									"protocol": schema.StringAttribute{
+										Computed:    true,
+										Description: "Protocol: tcp or udp.",
+									},
+								},
+							},
+						},
+						"volumes": schema.ListAttribute{
+							Computed:    true,
+							ElementType: types.StringType,
+							Description: "Named volumes the service mounts.",
+						},
+					},
+				},
+			},
+			"volumes": schema.ListAttribute{
+				Computed:    true,
+				ElementType: types.StringType,
+				Description: "Named volumes declared at the top level of the compose file, sorted.",
+			},
+		},
+	}
+}
+
+func (d *ComposeServicesDataSource) Configure(_ context.Context, req datasource.ConfigureRequest, resp *datasource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+	c, ok := req.ProviderData.(*client.DokployClient)
+	if !ok {
+		resp.Diagnostics.AddError("Unexpected Data Source Configure Type", fmt.Sprintf("Expected *client.DokployClient, got: %T", req.ProviderData))
+		return
+	}
+	d.client = c
+}
+
+func (d *ComposeServicesDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	var data ComposeServicesDataSourceModel
+	diags := req.Config.Get(ctx, &data)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	content := data.ComposeFileContent.ValueString()
+	if !data.ComposeID.IsNull() {
+		comp, err := d.client.GetCompose(data.ComposeID.ValueString())
+		if err != nil {
+			resp.Diagnostics.AddError("Unable to Read Compose", err.Error())
+			return
+		}
+		content = comp.ComposeFile
+	}
+
+	var services []composeService
+	var volumes []string
+	if strings.TrimSpace(content) == "" && !data.ComposeID.IsNull() {
+		// The compose file lives in git; Dokploy only reports the service names.
+		names, err := d.client.LoadComposeServices(data.ComposeID.ValueString())
+		if err != nil {
+			resp.Diagnostics.AddError("Unable to Load Compose Services", err.Error())
+			return
+		}
+		for _, name := range names {
+			services = append(services, composeService{Name: name})
+		}
+	} else {
+		var err error
+		services, volumes, err = parseComposeServices(content)
+		if err != nil {
+			resp.Diagnostics.AddError("Invalid Compose File", err.Error())
+			return
+		}
+	}
+
+	data.ServiceNames = make([]types.String, len(services))
+	data.Services = make([]ComposeServiceDataModel, len(services))
+	for i, svc := range services {
+		data.ServiceNames[i] = types.StringValue(svc.Name)
+		service := ComposeServiceDataModel{
+			Name:    types.StringValue(svc.Name),
+			Ports:   make([]ComposeServicePortDataModel, len(svc.Ports)),
+			Volumes: make([]types.String, len(svc.Volumes)),
+		}
+		for j, p := range svc.Ports {
+			service.Ports[j] = ComposeServicePortDataModel{
+				Target:    types.StringValue(p.Target),
+				Published: types.StringNull(),
+				Protocol:  types.StringValue(p.Protocol),
+			}
+			if p.Published != "" {
+				service.Ports[j].Published = types.StringValue(p.Published)
+			}
+		}
+		for j, v := range svc.Volumes {
+			service.Volumes[j] = types.StringValue(v)
+		}
+		data.Services[i] = service
+	}
+	data.Volumes = make([]types.String, len(volumes))
+	for i, v := range volumes {
+		data.Volumes[i] = types.StringValue(v)
+	}
+
+	diags = resp.State.Set(ctx, &data)
+	resp.Diagnostics.Append(diags...)
+}
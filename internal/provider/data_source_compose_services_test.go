@@ -0,0 +1,91 @@
+package provider
+
+import (
+	"fmt"
+	"os"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+)
+
+func TestAccComposeServicesDataSource(t *testing.T) {
+	host := os.Getenv("DOKPLOY_HOST")
+	apiKey := os.Getenv("DOKPLOY_API_KEY")
+
+	if host == "" || apiKey == "" {
+		t.Skip("DOKPLOY_HOST and DOKPLOY_API_KEY must be set for acceptance tests")
+	}
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccComposeServicesDataSourceConfig(),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					// Compose file content is parsed directly
+					resource.TestCheckResourceAttr("data.dokploy_compose_services.content", "service_names.#", "2"),
+					resource.TestCheckResourceAttr("data.dokploy_compose_services.content", "service_names.0", "db"),
+					resource.TestCheckResourceAttr("data.dokploy_compose_services.content", "services.1.name", "web"),
+					resource.TestCheckResourceAttr("data.dokploy_compose_services.content", "services.1.ports.0.published", "8080"),
+					resource.TestCheckResourceAttr("data.dokploy_compose_services.content", "services.1.ports.0.target", "80"),
+					resource.TestCheckResourceAttr("data.dokploy_compose_services.content", "services.0.volumes.0", "pgdata"),
+					resource.TestCheckResourceAttr("data.dokploy_compose_services.content", "volumes.0", "pgdata"),
+					// The stored compose file of a stack is loaded through Dokploy
+					resource.TestCheckResourceAttr("data.dokploy_compose_services.stack", "service_names.#", "2"),
+					resource.TestCheckResourceAttr("data.dokploy_compose_services.stack", "service_names.1", "web"),
+				),
+			},
+		},
+	})
+}
+
+func testAccComposeServicesDataSourceConfig() string {
+	return fmt.Sprintf(`
+provider "dokploy" {
+  host    = "%s"
+  api_key = "%s"
+}
+
+locals {
+  compose_file = <<EOF
+services:
+  web:
+    image: nginx:latest
+    ports:
+      - "8080:80"
+  db:
+    image: postgres:16
+    volumes:
+      - pgdata:/var/lib/postgresql/data
+volumes:
+  pgdata:
+EOF
+}
+
+resource "dokploy_project" "test" {
+  name        = "test-compose-services-project"
+  description = "Test project for compose services data source"
+}
+
+resource "dokploy_environment" "test" {
+  project_id = dokploy_project.test.id
+  name       = "test-compose-services-env"
+}
+
+resource "dokploy_compose" "test" {
+  environment_id       = dokploy_environment.test.id
+  name                 = "test-compose-services"
+  source_type          = "raw"
+  compose_file_content = local.compose_file
+}
+
+data "dokploy_compose_services" "content" {
+  compose_file_content = local.compose_file
+}
+
+data "dokploy_compose_services" "stack" {
+  compose_id = dokploy_compose.test.id
+}
+`, os.Getenv("DOKPLOY_HOST"), os.Getenv("DOKPLOY_API_KEY"))
+}
@@ -0,0 +1,182 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+	"slices"
+	"sort"
+
+	"github.com/ahmedali6/terraform-provider-dokploy/internal/client"
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+var _ datasource.DataSource = &ComposeTemplatesDataSource{}
+
+func NewComposeTemplatesDataSource() datasource.DataSource {
+	return &ComposeTemplatesDataSource{}
+}
+
+type ComposeTemplatesDataSource struct {
+	client *client.DokployClient
+}
+
+type ComposeTemplatesDataSourceModel struct {
+	// Filter parameters
+	BaseURL types.String `tfsdk:"base_url"`
+	Tag     types.String `tfsdk:"tag"`
+
+	// Results
+	Templates []ComposeTemplateDataModel `tfsdk:"templates"`
+	Tags      []types.String             `tfsdk:"tags"`
+}
+
+type ComposeTemplateDataModel struct {
+	ID          types.String   `tfsdk:"id"`
+	Name        types.String   `tfsdk:"name"`
+	Version     types.String   `tfsdk:"version"`
+	Description types.String   `tfsdk:"description"`
+	Logo        types.String   `tfsdk:"logo"`
+	Github      types.String   `tfsdk:"github"`
+	Website     types.String   `tfsdk:"website"`
+	Docs        types.String   `tfsdk:"docs"`
+	Tags        []types.String `tfsdk:"tags"`
+}
+
+func (d *ComposeTemplatesDataSource) Metadata(_ context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_compose_templates"
+}
+
+func (d *ComposeTemplatesDataSource) Schema(_ context.Context, _ datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Description: "Lists the templates of the Dokploy template catalog, which can be deployed with dokploy_compose_template.",
+		Attributes: map[string]schema.Attribute{
+			"base_url": schema.StringAttribute{
+				Optional:    true,
+				Description: "Base URL of a custom template catalog. Omit for the default Dokploy catalog.",
+			},
+			"tag": schema.StringAttribute{
+				Optional:    true,
+				Description: "Only list templates with this tag (e.g., 'analytics').",
+			},
+			"templates": schema.ListNestedAttribute{
+				Computed:    true,
+				Description: "The templates, in catalog order.",
+				NestedObject: schema.NestedAttributeObject{
+					Attributes: map[string]schema.Attribute{
+						"id": schema.StringAttribute{
+							Computed:    true,
+							Description: "The template ID, used as template_id of dokploy_compose_template.",
+						},
+						"name": schema.StringAttribute{
+							Computed:    true,
+							Description: "Display name.",
+						},
+						"version": schema.StringAttribute{
+							Computed:    true,
+							Description: "Version of the packaged software.",
+						},
+						"description": schema.StringAttribute{
+							Computed:    true,
+							Description: "Description of the software.",
+						},
+						"logo": schema.StringAttribute{
+							Computed:    true,
+							Description: "Logo file name.",
+						},
+						"github": schema.StringAttribute{
+							Computed:    true,
+							Description: "Source repository URL.",
+						},
+						"website": schema.StringAttribute{
+							Computed:    true,
+							Description: "Project website URL.",
+						},
+						"docs": schema.StringAttribute{
+							Computed:    true,
+							Description: "Documentation URL.",
+						},
+						"tags": schema.ListAttribute{
+							Computed:    true,
+							ElementType: types.StringType,
+							Description: "Catalog tags.",
+						},
+					},
+				},
+			},
+			"tags": schema.ListAttribute{
+				Computed:    true,
+				ElementType: types.StringType,
+				Description: "All tags used in the catalog, sorted.",
+			},
+		},
+	}
+}
+
+func (d *ComposeTemplatesDataSource) Configure(_ context.Context, req datasource.ConfigureRequest, resp *datasource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+	c, ok := req.ProviderData.(*client.DokployClient)
+	if !ok {
+		resp.Diagnostics.AddError("Unexpected Data Source Configure Type", fmt.Sprintf("Expected *client.DokployClient, got: %T", req.ProviderData))
+		return
+	}
+	d.client = c
+}
+
+func (d *ComposeTemplatesDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	var data ComposeTemplatesDataSourceModel
+	diags := req.Config.Get(ctx, &data)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	templates, err := d.client.ListComposeTemplates(data.BaseURL.ValueString())
+	if err != nil {
+		resp.Diagnostics.AddError("Unable to List Compose Templates", err.Error())
+		return
+	}
+
+	allTags := map[string]bool{}
+	data.Templates = []ComposeTemplateDataModel{}
+	for _, t := range templates {
+		for _, tag := range t.Tags {
+			allTags[tag] = true
+		}
+		if !data.Tag.IsNull() && !slices.Contains(t.Tags, data.Tag.ValueString()) {
+			continue
+		}
+
+		template := ComposeTemplateDataModel{
+			ID:          types.StringValue(t.ID),
+			Name:        types.StringValue(t.Name),
+			Version:     types.StringValue(t.Version),
+			Description: types.StringValue(t.Description),
+			Logo:        optionalString(t.Logo),
+			Github:      optionalString(t.Links.Github),
+			Website:     optionalString(t.Links.Website),
+			Docs:        optionalString(t.Links.Docs),
+			Tags:        make([]types.String, len(t.Tags)),
+		}
+		for i, tag := range t.Tags {
+			template.Tags[i] = types.StringValue(tag)
+		}
+		data.Templates = append(data.Templates, template)
+	}
+
+	tags := make([]string, 0, len(allTags))
+	for tag := range allTags {
+		tags = append(tags, tag)
+	}
+	sort.Strings(tags)
+	data.Tags = make([]types.String, len(tags))
+	for i, tag := range tags {
+		data.Tags[i] = types.StringValue(tag)
+	}
+
+	diags = resp.State.Set(ctx, &data)
+	resp.Diagnostics.Append(diags...)
+}
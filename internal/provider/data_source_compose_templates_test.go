@@ -0,0 +1,51 @@
+package provider
+
+import (
+	"fmt"
+	"os"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+)
+
+func TestAccComposeTemplatesDataSource(t *testing.T) {
+	host := os.Getenv("DOKPLOY_HOST")
+	apiKey := os.Getenv("DOKPLOY_API_KEY")
+
+	if host == "" || apiKey == "" {
+		t.Skip("DOKPLOY_HOST and DOKPLOY_API_KEY must be set for acceptance tests")
+	}
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccComposeTemplatesDataSourceConfig(host, apiKey),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttrSet("data.dokploy_compose_templates.all", "templates.#"),
+					resource.TestCheckResourceAttrSet("data.dokploy_compose_templates.all", "tags.#"),
+					resource.TestCheckTypeSetElemNestedAttrs("data.dokploy_compose_templates.all", "templates.*", map[string]string{
+						"id": "plausible",
+					}),
+					resource.TestCheckTypeSetElemAttr("data.dokploy_compose_templates.analytics", "templates.0.tags.*", "analytics"),
+				),
+			},
+		},
+	})
+}
+
+func testAccComposeTemplatesDataSourceConfig(host, apiKey string) string {
+	return fmt.Sprintf(`
+provider "dokploy" {
+  host    = "%s"
+  api_key = "%s"
+}
+
+data "dokploy_compose_templates" "all" {}
+
+data "dokploy_compose_templates" "analytics" {
+  tag = "analytics"
+}
+`, host, apiKey)
+}
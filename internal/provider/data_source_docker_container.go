@@ -5,6 +5,7 @@ import (
 	"fmt"
 
 	"github.com/ahmedali6/terraform-provider-dokploy/internal/client"
+	"github.com/hashicorp/terraform-plugin-framework-jsontypes/jsontypes"
 	"github.com/hashicorp/terraform-plugin-framework/datasource"
 	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
 	"github.com/hashicorp/terraform-plugin-framework/types"
@@ -47,7 +48,7 @@ type DockerContainerDataSourceModel struct {
 	ConfigImage      types.String `tfsdk:"config_image"`
 	ConfigWorkingDir types.String `tfsdk:"config_working_dir"`
 	// Raw JSON output
-	ConfigJSON types.String `tfsdk:"config_json"`
+	ConfigJSON jsontypes.Normalized `tfsdk:"config_json"`
 }
 
 func (d *DockerContainerDataSource) Metadata(_ context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
@@ -155,6 +156,7 @@ func (d *DockerContainerDataSource) Schema(_ context.Context, _ datasource.Schem
 			},
 			// Raw JSON
 			"config_json": schema.StringAttribute{
+				CustomType:  jsontypes.NormalizedType{},
 				Computed:    true,
 				Description: "Full container configuration as raw JSON. Useful for accessing all fields via jsondecode().",
 			},
@@ -230,7 +232,7 @@ func (d *DockerContainerDataSource) Read(ctx context.Context, req datasource.Rea
 	data.ConfigWorkingDir = types.StringValue(config.Config.WorkingDir)
 
 	// Raw JSON
-	data.ConfigJSON = types.StringValue(rawJSON)
+	data.ConfigJSON = jsontypes.NewNormalizedValue(rawJSON)
 
 	diags = resp.State.Set(ctx, &data)
 	resp.Diagnostics.Append(diags...)
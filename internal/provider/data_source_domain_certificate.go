@@ -0,0 +1,187 @@
+package provider
+
+import (
+	"context"
+	"crypto/x509"
+	"encoding/base64"
+	"encoding/pem"
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/ahmedali6/terraform-provider-dokploy/internal/client"
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+var _ datasource.DataSource = &DomainCertificateDataSource{}
+
+func NewDomainCertificateDataSource() datasource.DataSource {
+	return &DomainCertificateDataSource{}
+}
+
+type DomainCertificateDataSource struct {
+	client *client.DokployClient
+}
+
+type DomainCertificateDataSourceModel struct {
+	Host         types.String `tfsdk:"host"`
+	ServerID     types.String `tfsdk:"server_id"`
+	Found        types.Bool   `tfsdk:"found"`
+	Resolver     types.String `tfsdk:"resolver"`
+	Domains      types.List   `tfsdk:"domains"`
+	Issuer       types.String `tfsdk:"issuer"`
+	SerialNumber types.String `tfsdk:"serial_number"`
+	NotBefore    types.String `tfsdk:"not_before"`
+	NotAfter     types.String `tfsdk:"not_after"`
+}
+
+func (d *DomainCertificateDataSource) Metadata(_ context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_domain_certificate"
+}
+
+func (d *DomainCertificateDataSource) Schema(_ context.Context, _ datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Description: "Reports the certificate Traefik obtained through ACME (e.g., Let's Encrypt) for a host. " +
+			"When Traefik holds several certificates covering the host, the one expiring last is reported.",
+		Attributes: map[string]schema.Attribute{
+			"host": schema.StringAttribute{
+				Required:    true,
+				Description: "The host name to look up. Wildcard certificates covering the host match.",
+			},
+			"server_id": schema.StringAttribute{
+				Optional:    true,
+				Description: "ID of the remote server running Traefik. Omit for the Dokploy host.",
+			},
+			"found": schema.BoolAttribute{
+				Computed:    true,
+				Description: "Whether Traefik holds a certificate for the host. The remaining attributes are null when false.",
+			},
+			"resolver": schema.StringAttribute{
+				Computed:    true,
+				Description: "The Traefik certificate resolver that obtained the certificate.",
+			},
+			"domains": schema.ListAttribute{
+				Computed:    true,
+				ElementType: types.StringType,
+				Description: "The domains the certificate covers, main domain first.",
+			},
+			"issuer": schema.StringAttribute{
+				Computed:    true,
+				Description: "The certificate issuer's distinguished name.",
+			},
+			"serial_number": schema.StringAttribute{
+				Computed:    true,
+				Description: "The certificate serial number in hexadecimal.",
+			},
+			"not_before": schema.StringAttribute{
+				Computed:    true,
+				Description: "Start of the certificate validity period (RFC 3339).",
+			},
+			"not_after": schema.StringAttribute{
+				Computed:    true,
+				Description: "End of the certificate validity period (RFC 3339).",
+			},
+		},
+	}
+}
+
+func (d *DomainCertificateDataSource) Configure(_ context.Context, req datasource.ConfigureRequest, resp *datasource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+	c, ok := req.ProviderData.(*client.DokployClient)
+	if !ok {
+		resp.Diagnostics.AddError("Unexpected Data Source Configure Type", fmt.Sprintf("Expected *client.DokployClient, got: %T", req.ProviderData))
+		return
+	}
+	d.client = c
+}
+
+func (d *DomainCertificateDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	var data DomainCertificateDataSourceModel
+	diags := req.Config.Get(ctx, &data)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	certificates, err := d.client.GetAcmeCertificates(data.ServerID.ValueString())
+	if err != nil {
+		resp.Diagnostics.AddError("Unable to Read ACME Certificates", err.Error())
+		return
+	}
+
+	host := strings.ToLower(data.Host.ValueString())
+	var match *client.AcmeCertificate
+	var matchCert *x509.Certificate
+	for i, acme := range certificates {
+		domains := append([]string{acme.Main}, acme.SANs...)
+		if !certificateCoversHost(domains, host) {
+			continue
+		}
+		cert, err := parseAcmeCertificate(acme.Certificate)
+		if err != nil {
+			resp.Diagnostics.AddError("Unable to Parse Certificate", fmt.Sprintf("Certificate for %s from resolver %s: %s", acme.Main, acme.Resolver, err))
+			return
+		}
+		if matchCert == nil || cert.NotAfter.After(matchCert.NotAfter) {
+			match = &certificates[i]
+			matchCert = cert
+		}
+	}
+
+	data.Found = types.BoolValue(match != nil)
+	if match == nil {
+		data.Resolver = types.StringNull()
+		data.Domains = types.ListNull(types.StringType)
+		data.Issuer = types.StringNull()
+		data.SerialNumber = types.StringNull()
+		data.NotBefore = types.StringNull()
+		data.NotAfter = types.StringNull()
+	} else {
+		domains, diags := types.ListValueFrom(ctx, types.StringType, append([]string{match.Main}, match.SANs...))
+		resp.Diagnostics.Append(diags...)
+		data.Resolver = types.StringValue(match.Resolver)
+		data.Domains = domains
+		data.Issuer = types.StringValue(matchCert.Issuer.String())
+		data.SerialNumber = types.StringValue(matchCert.SerialNumber.Text(16))
+		data.NotBefore = types.StringValue(matchCert.NotBefore.UTC().Format(time.RFC3339))
+		data.NotAfter = types.StringValue(matchCert.NotAfter.UTC().Format(time.RFC3339))
+	}
+
+	diags = resp.State.Set(ctx, &data)
+	resp.Diagnostics.Append(diags...)
+}
+
+// certificateCoversHost reports whether any of the certificate domains
+// matches host, treating "*.example.com" as matching a single label.
+func certificateCoversHost(domains []string, host string) bool {
+	for _, domain := range domains {
+		domain = strings.ToLower(domain)
+		if domain == host {
+			return true
+		}
+		if suffix, ok := strings.CutPrefix(domain, "*."); ok {
+			if label, ok := strings.CutSuffix(host, "."+suffix); ok && label != "" && !strings.Contains(label, ".") {
+				return true
+			}
+		}
+	}
+	return false
+}
+
+// parseAcmeCertificate decodes the leaf certificate of a base64-encoded PEM
+// chain as stored by Traefik.
+func parseAcmeCertificate(encoded string) (*x509.Certificate, error) {
+	chain, err := base64.StdEncoding.DecodeString(encoded)
+	if err != nil {
+		return nil, fmt.Errorf("invalid base64: %w", err)
+	}
+	block, _ := pem.Decode(chain)
+	if block == nil {
+		return nil, fmt.Errorf("no PEM block found")
+	}
+	return x509.ParseCertificate(block.Bytes)
+}
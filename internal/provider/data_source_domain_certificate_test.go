@@ -0,0 +1,46 @@
+package provider
+
+import (
+	"fmt"
+	"os"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+)
+
+func TestAccDomainCertificateDataSource(t *testing.T) {
+	host := os.Getenv("DOKPLOY_HOST")
+	apiKey := os.Getenv("DOKPLOY_API_KEY")
+
+	if host == "" || apiKey == "" {
+		t.Skip("DOKPLOY_HOST and DOKPLOY_API_KEY must be set for acceptance tests")
+	}
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			// No certificate is ever issued for the reserved example.invalid
+			{
+				Config: testAccDomainCertificateDataSourceConfig("tf-acc.example.invalid"),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("data.dokploy_domain_certificate.test", "found", "false"),
+					resource.TestCheckNoResourceAttr("data.dokploy_domain_certificate.test", "not_after"),
+				),
+			},
+		},
+	})
+}
+
+func testAccDomainCertificateDataSourceConfig(certHost string) string {
+	return fmt.Sprintf(`
+provider "dokploy" {
+  host    = "%s"
+  api_key = "%s"
+}
+
+data "dokploy_domain_certificate" "test" {
+  host = "%s"
+}
+`, os.Getenv("DOKPLOY_HOST"), os.Getenv("DOKPLOY_API_KEY"), certHost)
+}
@@ -0,0 +1,260 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/ahmedali6/terraform-provider-dokploy/internal/client"
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+var _ datasource.DataSource = &EnvironmentDataSource{}
+
+func NewEnvironmentDataSource() datasource.DataSource {
+	return &EnvironmentDataSource{}
+}
+
+type EnvironmentDataSource struct {
+	client *client.DokployClient
+}
+
+// EnvironmentDataModel is an environment with the services deployed in it.
+// It is the model of the dokploy_environment data source and of the
+// environments listed by the project data sources.
+type EnvironmentDataModel struct {
+	ID           types.String                  `tfsdk:"id"`
+	Name         types.String                  `tfsdk:"name"`
+	Description  types.String                  `tfsdk:"description"`
+	ProjectID    types.String                  `tfsdk:"project_id"`
+	Applications []EnvironmentServiceDataModel `tfsdk:"applications"`
+	Composes     []EnvironmentServiceDataModel `tfsdk:"composes"`
+	Databases    []EnvironmentServiceDataModel `tfsdk:"databases"`
+}
+
+// EnvironmentServiceDataModel is an application, compose stack or database
+// of an environment.
+type EnvironmentServiceDataModel struct {
+	ID       types.String `tfsdk:"id"`
+	Type     types.String `tfsdk:"type"`
+	Name     types.String `tfsdk:"name"`
+	AppName  types.String `tfsdk:"app_name"`
+	ServerID types.String `tfsdk:"server_id"`
+	Status   types.String `tfsdk:"status"`
+}
+
+func (d *EnvironmentDataSource) Metadata(_ context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_environment"
+}
+
+func (d *EnvironmentDataSource) Schema(_ context.Context, _ datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	attributes := environmentDataSourceAttributes()
+	attributes["id"] = schema.StringAttribute{
+		Optional:    true,
+		Computed:    true,
+		Description: "The ID of the environment. Exactly one of id or name must be specified.",
+		Validators: []validator.String{
+			stringvalidator.ExactlyOneOf(path.MatchRoot("name")),
+		},
+	}
+	attributes["name"] = schema.StringAttribute{
+		Optional:    true,
+		Computed:    true,
+		Description: "The name of the environment. Requires project_id.",
+		Validators: []validator.String{
+			stringvalidator.AlsoRequires(path.MatchRoot("project_id")),
+		},
+	}
+	attributes["project_id"] = schema.StringAttribute{
+		Optional:    true,
+		Computed:    true,
+		Description: "The ID of the project the environment belongs to. Required when looking up the environment by name.",
+	}
+	resp.Schema = schema.Schema{
+		Description: "Fetches a Dokploy environment by its ID, or by project and name, with the applications, compose stacks and databases deployed in it.",
+		Attributes:  attributes,
+	}
+}
+
+// environmentDataSourceAttributes returns the computed attributes of an
+// environment and its services.
+func environmentDataSourceAttributes() map[string]schema.Attribute {
+	serviceAttributes := func(kind string) map[string]schema.Attribute {
+		return map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				Computed:    true,
+				Description: fmt.Sprintf("The ID of the %s.", kind),
+			},
+			"type": schema.StringAttribute{
+				Computed:    true,
+				Description: "The service type: application, compose, postgres, mysql, mariadb, mongo or redis.",
+			},
+			"name": schema.StringAttribute{
+				Computed:    true,
+				Description: fmt.Sprintf("The display name of the %s.", kind),
+			},
+			"app_name": schema.StringAttribute{
+				Computed:    true,
+				Description: "The app name used for Docker container naming.",
+			},
+			"server_id": schema.StringAttribute{
+				Computed:    true,
+				Description: fmt.Sprintf("Server ID the %s is deployed to.", kind),
+			},
+			"status": schema.StringAttribute{
+				Computed:    true,
+				Description: "Current status: idle, running, done, or error.",
+			},
+		}
+	}
+	return map[string]schema.Attribute{
+		"id": schema.StringAttribute{
+			Computed:    true,
+			Description: "The ID of the environment.",
+		},
+		"name": schema.StringAttribute{
+			Computed:    true,
+			Description: "The name of the environment.",
+		},
+		"description": schema.StringAttribute{
+			Computed:    true,
+			Description: "Description of the environment.",
+		},
+		"project_id": schema.StringAttribute{
+			Computed:    true,
+			Description: "The ID of the project the environment belongs to.",
+		},
+		"applications": schema.ListNestedAttribute{
+			Computed:    true,
+			Description: "Applications in the environment.",
+			NestedObject: schema.NestedAttributeObject{
+				Attributes: serviceAttributes("application"),
+			},
+		},
+		"composes": schema.ListNestedAttribute{
+			Computed:    true,
+			Description: "Compose stacks in the environment.",
+			NestedObject: schema.NestedAttributeObject{
+				Attributes: serviceAttributes("compose stack"),
+			},
+		},
+		"databases": schema.ListNestedAttribute{
+			Computed:    true,
+			Description: "Databases of every type in the environment.",
+			NestedObject: schema.NestedAttributeObject{
+				Attributes: serviceAttributes("database"),
+			},
+		},
+	}
+}
+
+// newEnvironmentDataModel maps an environment and its services. The project
+// ID is used when the environment does not report one, as environments nested
+// in a project may not.
+func newEnvironmentDataModel(env client.Environment, projectID string) EnvironmentDataModel {
+	service := func(serviceType, id, name, appName, serverID, status string) EnvironmentServiceDataModel {
+		m := EnvironmentServiceDataModel{
+			ID:       types.StringValue(id),
+			Type:     types.StringValue(serviceType),
+			Name:     types.StringValue(name),
+			AppName:  types.StringValue(appName),
+			ServerID: types.StringNull(),
+			Status:   types.StringNull(),
+		}
+		if serverID != "" {
+			m.ServerID = types.StringValue(serverID)
+		}
+		if status != "" {
+			m.Status = types.StringValue(status)
+		}
+		return m
+	}
+
+	if env.ProjectID != "" {
+		projectID = env.ProjectID
+	}
+	m := EnvironmentDataModel{
+		ID:           types.StringValue(env.ID),
+		Name:         types.StringValue(env.Name),
+		Description:  types.StringValue(env.Description),
+		ProjectID:    types.StringValue(projectID),
+		Applications: []EnvironmentServiceDataModel{},
+		Composes:     []EnvironmentServiceDataModel{},
+		Databases:    []EnvironmentServiceDataModel{},
+	}
+	for _, app := range env.Applications {
+		m.Applications = append(m.Applications, service("application", app.ID, app.Name, app.AppName, app.ServerID, app.ApplicationStatus))
+	}
+	for _, comp := range env.Compose {
+		m.Composes = append(m.Composes, service("compose", comp.ID, comp.Name, comp.AppName, comp.ServerID, comp.ComposeStatus))
+	}
+	for _, dbs := range []struct {
+		dbType string
+		list   []client.Database
+	}{
+		{"postgres", env.Postgres},
+		{"mysql", env.Mysql},
+		{"mariadb", env.Mariadb},
+		{"mongo", env.Mongo},
+		{"redis", env.Redis},
+	} {
+		for _, db := range dbs.list {
+			m.Databases = append(m.Databases, service(dbs.dbType, databaseTypedID(db), db.Name, db.AppName, db.ServerID, db.ApplicationStatus))
+		}
+	}
+	return m
+}
+
+func (d *EnvironmentDataSource) Configure(_ context.Context, req datasource.ConfigureRequest, resp *datasource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+	client, ok := req.ProviderData.(*client.DokployClient)
+	if !ok {
+		resp.Diagnostics.AddError("Unexpected Data Source Configure Type", fmt.Sprintf("Expected *client.DokployClient, got: %T", req.ProviderData))
+		return
+	}
+	d.client = client
+}
+
+func (d *EnvironmentDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	var data EnvironmentDataModel
+	diags := req.Config.Get(ctx, &data)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	envID := data.ID.ValueString()
+	projectID := data.ProjectID.ValueString()
+	if envID == "" {
+		env, err := resolveEnvironmentPath(d.client, projectID, data.Name.ValueString())
+		if err != nil {
+			resp.Diagnostics.AddError("Unable to Find Environment", err.Error())
+			return
+		}
+		envID = env.ID
+		projectID = env.ProjectID
+	}
+
+	env, err := d.client.GetEnvironment(envID)
+	if err != nil {
+		resp.Diagnostics.AddError("Unable to Read Environment", err.Error())
+		return
+	}
+	if env.ProjectID == "" && projectID == "" {
+		projectID, err = findEnvironmentProject(d.client, envID)
+		if err != nil {
+			resp.Diagnostics.AddError("Unable to Read Environment", err.Error())
+			return
+		}
+	}
+
+	data = newEnvironmentDataModel(*env, projectID)
+	diags = resp.State.Set(ctx, &data)
+	resp.Diagnostics.Append(diags...)
+}
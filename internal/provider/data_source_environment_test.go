@@ -0,0 +1,90 @@
+package provider
+
+import (
+	"fmt"
+	"os"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+)
+
+func TestAccEnvironmentDataSource(t *testing.T) {
+	host := os.Getenv("DOKPLOY_HOST")
+	apiKey := os.Getenv("DOKPLOY_API_KEY")
+
+	if host == "" || apiKey == "" {
+		t.Skip("DOKPLOY_HOST and DOKPLOY_API_KEY must be set for acceptance tests")
+	}
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccEnvironmentDataSourceConfig(),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					// Look up by project and name
+					resource.TestCheckResourceAttrPair("data.dokploy_environment.by_name", "id", "dokploy_environment.test", "id"),
+					resource.TestCheckResourceAttrPair("data.dokploy_environment.by_name", "project_id", "dokploy_project.test", "id"),
+					resource.TestCheckResourceAttr("data.dokploy_environment.by_name", "composes.#", "1"),
+					resource.TestCheckResourceAttr("data.dokploy_environment.by_name", "composes.0.name", "test-ds-env-compose"),
+					resource.TestCheckResourceAttr("data.dokploy_environment.by_name", "composes.0.type", "compose"),
+					// Look up by ID
+					resource.TestCheckResourceAttr("data.dokploy_environment.by_id", "name", "test-ds-env"),
+					resource.TestCheckResourceAttrPair("data.dokploy_environment.by_id", "project_id", "dokploy_project.test", "id"),
+					// Filtered list
+					resource.TestCheckResourceAttr("data.dokploy_environments.test", "environments.#", "1"),
+					resource.TestCheckResourceAttrPair("data.dokploy_environments.test", "environments.0.id", "dokploy_environment.test", "id"),
+				),
+			},
+		},
+	})
+}
+
+func testAccEnvironmentDataSourceConfig() string {
+	return fmt.Sprintf(`
+provider "dokploy" {
+  host    = "%s"
+  api_key = "%s"
+}
+
+resource "dokploy_project" "test" {
+  name        = "test-ds-env-project"
+  description = "Test project for environment data source"
+}
+
+resource "dokploy_environment" "test" {
+  project_id = dokploy_project.test.id
+  name       = "test-ds-env"
+}
+
+resource "dokploy_compose" "test" {
+  environment_id = dokploy_environment.test.id
+  name           = "test-ds-env-compose"
+  source_type    = "raw"
+  compose_file_content = <<-EOT
+    services:
+      web:
+        image: nginx:latest
+  EOT
+}
+
+data "dokploy_environment" "by_name" {
+  project_id = dokploy_project.test.id
+  name       = dokploy_environment.test.name
+
+  depends_on = [dokploy_compose.test]
+}
+
+data "dokploy_environment" "by_id" {
+  id = dokploy_environment.test.id
+
+  depends_on = [dokploy_compose.test]
+}
+
+data "dokploy_environments" "test" {
+  project_id = dokploy_project.test.id
+  name       = dokploy_environment.test.name
+}
+`, os.Getenv("DOKPLOY_HOST"), os.Getenv("DOKPLOY_API_KEY"))
+}
@@ -0,0 +1,97 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/ahmedali6/terraform-provider-dokploy/internal/client"
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+var _ datasource.DataSource = &EnvironmentsDataSource{}
+
+func NewEnvironmentsDataSource() datasource.DataSource {
+	return &EnvironmentsDataSource{}
+}
+
+type EnvironmentsDataSource struct {
+	client *client.DokployClient
+}
+
+type EnvironmentsDataSourceModel struct {
+	ProjectID    types.String           `tfsdk:"project_id"`
+	Name         types.String           `tfsdk:"name"`
+	Environments []EnvironmentDataModel `tfsdk:"environments"`
+}
+
+func (d *EnvironmentsDataSource) Metadata(_ context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_environments"
+}
+
+func (d *EnvironmentsDataSource) Schema(_ context.Context, _ datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Description: "Fetches Dokploy environments with the applications, compose stacks and databases deployed in them, optionally filtered by project and name.",
+		Attributes: map[string]schema.Attribute{
+			"project_id": schema.StringAttribute{
+				Optional:    true,
+				Description: "Optional project ID to filter environments. If not provided, returns the environments of all projects.",
+			},
+			"name": schema.StringAttribute{
+				Optional:    true,
+				Description: "Optional environment name to filter environments, for example to find the production environment of every project.",
+			},
+			"environments": schema.ListNestedAttribute{
+				Computed:    true,
+				Description: "List of environments.",
+				NestedObject: schema.NestedAttributeObject{
+					Attributes: environmentDataSourceAttributes(),
+				},
+			},
+		},
+	}
+}
+
+func (d *EnvironmentsDataSource) Configure(_ context.Context, req datasource.ConfigureRequest, resp *datasource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+	client, ok := req.ProviderData.(*client.DokployClient)
+	if !ok {
+		resp.Diagnostics.AddError("Unexpected Data Source Configure Type", fmt.Sprintf("Expected *client.DokployClient, got: %T", req.ProviderData))
+		return
+	}
+	d.client = client
+}
+
+func (d *EnvironmentsDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	var data EnvironmentsDataSourceModel
+	diags := req.Config.Get(ctx, &data)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	projects, err := d.client.ListProjects()
+	if err != nil {
+		resp.Diagnostics.AddError("Unable to List Projects", err.Error())
+		return
+	}
+
+	data.Environments = []EnvironmentDataModel{}
+	for _, project := range projects {
+		if !data.ProjectID.IsNull() && data.ProjectID.ValueString() != project.ID {
+			continue
+		}
+		for _, env := range project.Environments {
+			if !data.Name.IsNull() && data.Name.ValueString() != env.Name {
+				continue
+			}
+			data.Environments = append(data.Environments, newEnvironmentDataModel(env, project.ID))
+		}
+	}
+
+	diags = resp.State.Set(ctx, &data)
+	resp.Diagnostics.Append(diags...)
+}
@@ -0,0 +1,153 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/ahmedali6/terraform-provider-dokploy/internal/client"
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+var _ datasource.DataSource = &ProjectDataSource{}
+
+func NewProjectDataSource() datasource.DataSource {
+	return &ProjectDataSource{}
+}
+
+type ProjectDataSource struct {
+	client *client.DokployClient
+}
+
+// ProjectDataModel is a project with its environment tree. It is the model of
+// the dokploy_project data source and of the projects listed by
+// dokploy_projects.
+type ProjectDataModel struct {
+	ID           types.String           `tfsdk:"id"`
+	Name         types.String           `tfsdk:"name"`
+	Description  types.String           `tfsdk:"description"`
+	Environments []EnvironmentDataModel `tfsdk:"environments"`
+}
+
+func (d *ProjectDataSource) Metadata(_ context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_project"
+}
+
+func (d *ProjectDataSource) Schema(_ context.Context, _ datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	attributes := projectDataSourceAttributes()
+	attributes["id"] = schema.StringAttribute{
+		Optional:    true,
+		Computed:    true,
+		Description: "The ID of the project. Exactly one of id or name must be specified.",
+		Validators: []validator.String{
+			stringvalidator.ExactlyOneOf(path.MatchRoot("name")),
+		},
+	}
+	attributes["name"] = schema.StringAttribute{
+		Optional:    true,
+		Computed:    true,
+		Description: "The name of the project.",
+	}
+	resp.Schema = schema.Schema{
+		Description: "Fetches a Dokploy project by its ID or name, with its environments and the applications, compose stacks and databases deployed in them.",
+		Attributes:  attributes,
+	}
+}
+
+// projectDataSourceAttributes returns the computed attributes of a project
+// and its environment tree.
+func projectDataSourceAttributes() map[string]schema.Attribute {
+	return map[string]schema.Attribute{
+		"id": schema.StringAttribute{
+			Computed:    true,
+			Description: "The ID of the project.",
+		},
+		"name": schema.StringAttribute{
+			Computed:    true,
+			Description: "The name of the project.",
+		},
+		"description": schema.StringAttribute{
+			Computed:    true,
+			Description: "Description of the project.",
+		},
+		"environments": schema.ListNestedAttribute{
+			Computed:    true,
+			Description: "Environments of the project.",
+			NestedObject: schema.NestedAttributeObject{
+				Attributes: environmentDataSourceAttributes(),
+			},
+		},
+	}
+}
+
+func newProjectDataModel(project client.Project) ProjectDataModel {
+	m := ProjectDataModel{
+		ID:           types.StringValue(project.ID),
+		Name:         types.StringValue(project.Name),
+		Description:  types.StringValue(project.Description),
+		Environments: []EnvironmentDataModel{},
+	}
+	for _, env := range project.Environments {
+		m.Environments = append(m.Environments, newEnvironmentDataModel(env, project.ID))
+	}
+	return m
+}
+
+func (d *ProjectDataSource) Configure(_ context.Context, req datasource.ConfigureRequest, resp *datasource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+	client, ok := req.ProviderData.(*client.DokployClient)
+	if !ok {
+		resp.Diagnostics.AddError("Unexpected Data Source Configure Type", fmt.Sprintf("Expected *client.DokployClient, got: %T", req.ProviderData))
+		return
+	}
+	d.client = client
+}
+
+func (d *ProjectDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	var data ProjectDataModel
+	diags := req.Config.Get(ctx, &data)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	projectID := data.ID.ValueString()
+	if projectID == "" {
+		projects, err := d.client.ListProjects()
+		if err != nil {
+			resp.Diagnostics.AddError("Unable to List Projects", err.Error())
+			return
+		}
+		name := data.Name.ValueString()
+		for _, project := range projects {
+			if project.Name != name {
+				continue
+			}
+			if projectID != "" {
+				resp.Diagnostics.AddError("Unable to Find Project", fmt.Sprintf("More than one project is named %q; look it up by ID instead.", name))
+				return
+			}
+			projectID = project.ID
+		}
+		if projectID == "" {
+			resp.Diagnostics.AddError("Unable to Find Project", fmt.Sprintf("No project is named %q.", name))
+			return
+		}
+	}
+
+	project, err := d.client.GetProject(projectID)
+	if err != nil {
+		resp.Diagnostics.AddError("Unable to Read Project", err.Error())
+		return
+	}
+
+	data = newProjectDataModel(*project)
+	diags = resp.State.Set(ctx, &data)
+	resp.Diagnostics.Append(diags...)
+}
@@ -0,0 +1,94 @@
+package provider
+
+import (
+	"fmt"
+	"os"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+)
+
+func TestAccProjectDataSource(t *testing.T) {
+	host := os.Getenv("DOKPLOY_HOST")
+	apiKey := os.Getenv("DOKPLOY_API_KEY")
+
+	if host == "" || apiKey == "" {
+		t.Skip("DOKPLOY_HOST and DOKPLOY_API_KEY must be set for acceptance tests")
+	}
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccProjectDataSourceConfig(),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					// Look up by name
+					resource.TestCheckResourceAttrPair("data.dokploy_project.by_name", "id", "dokploy_project.test", "id"),
+					resource.TestCheckResourceAttr("data.dokploy_project.by_name", "description", "Test project for project data source"),
+					resource.TestCheckTypeSetElemNestedAttrs("data.dokploy_project.by_name", "environments.*", map[string]string{
+						"name":                "test-ds-project-env",
+						"applications.#":      "1",
+						"applications.0.name": "test-ds-project-app",
+						"applications.0.type": "application",
+						"databases.#":         "1",
+						"databases.0.type":    "redis",
+					}),
+					// Look up by ID
+					resource.TestCheckResourceAttr("data.dokploy_project.by_id", "name", "test-ds-project"),
+					resource.TestCheckResourceAttrPair("data.dokploy_project.by_id", "environments.#", "data.dokploy_project.by_name", "environments.#"),
+					resource.TestCheckResourceAttrSet("data.dokploy_projects.all", "projects.#"),
+				),
+			},
+		},
+	})
+}
+
+func testAccProjectDataSourceConfig() string {
+	return fmt.Sprintf(`
+provider "dokploy" {
+  host    = "%s"
+  api_key = "%s"
+}
+
+resource "dokploy_project" "test" {
+  name        = "test-ds-project"
+  description = "Test project for project data source"
+}
+
+resource "dokploy_environment" "test" {
+  project_id = dokploy_project.test.id
+  name       = "test-ds-project-env"
+}
+
+resource "dokploy_application" "test" {
+  environment_id = dokploy_environment.test.id
+  name           = "test-ds-project-app"
+  build_type     = "nixpacks"
+  source_type    = "docker"
+  docker_image   = "nginx:latest"
+}
+
+resource "dokploy_redis" "test" {
+  name              = "test-ds-project-redis"
+  database_password = "test_redis_password_123"
+  environment_id    = dokploy_environment.test.id
+}
+
+data "dokploy_project" "by_name" {
+  name = dokploy_project.test.name
+
+  depends_on = [dokploy_application.test, dokploy_redis.test]
+}
+
+data "dokploy_project" "by_id" {
+  id = dokploy_project.test.id
+
+  depends_on = [dokploy_application.test, dokploy_redis.test]
+}
+
+data "dokploy_projects" "all" {
+  depends_on = [dokploy_project.test]
+}
+`, os.Getenv("DOKPLOY_HOST"), os.Getenv("DOKPLOY_API_KEY"))
+}
@@ -0,0 +1,78 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/ahmedali6/terraform-provider-dokploy/internal/client"
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+)
+
+var _ datasource.DataSource = &ProjectsDataSource{}
+
+func NewProjectsDataSource() datasource.DataSource {
+	return &ProjectsDataSource{}
+}
+
+type ProjectsDataSource struct {
+	client *client.DokployClient
+}
+
+type ProjectsDataSourceModel struct {
+	Projects []ProjectDataModel `tfsdk:"projects"`
+}
+
+func (d *ProjectsDataSource) Metadata(_ context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_projects"
+}
+
+func (d *ProjectsDataSource) Schema(_ context.Context, _ datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Description: "Fetches all Dokploy projects with their environments and the applications, compose stacks and databases deployed in them.",
+		Attributes: map[string]schema.Attribute{
+			"projects": schema.ListNestedAttribute{
+				Computed:    true,
+				Description: "List of projects.",
+				NestedObject: schema.NestedAttributeObject{
+					Attributes: projectDataSourceAttributes(),
+				},
+			},
+		},
+	}
+}
+
+func (d *ProjectsDataSource) Configure(_ context.Context, req datasource.ConfigureRequest, resp *datasource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+	client, ok := req.ProviderData.(*client.DokployClient)
+	if !ok {
+		resp.Diagnostics.AddError("Unexpected Data Source Configure Type", fmt.Sprintf("Expected *client.DokployClient, got: %T", req.ProviderData))
+		return
+	}
+	d.client = client
+}
+
+func (d *ProjectsDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	var data ProjectsDataSourceModel
+	diags := req.Config.Get(ctx, &data)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	projects, err := d.client.ListProjects()
+	if err != nil {
+		resp.Diagnostics.AddError("Unable to List Projects", err.Error())
+		return
+	}
+
+	data.Projects = make([]ProjectDataModel, len(projects))
+	for i, project := range projects {
+		data.Projects[i] = newProjectDataModel(project)
+	}
+
+	diags = resp.State.Set(ctx, &data)
+	resp.Diagnostics.Append(diags...)
+}
@@ -0,0 +1,143 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/ahmedali6/terraform-provider-dokploy/internal/client"
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+var _ datasource.DataSource = &ScheduleRunsDataSource{}
+
+func NewScheduleRunsDataSource() datasource.DataSource {
+	return &ScheduleRunsDataSource{}
+}
+
+type ScheduleRunsDataSource struct {
+	client *client.DokployClient
+}
+
+type ScheduleRunsDataSourceModel struct {
+	ScheduleID types.String       `tfsdk:"schedule_id"`
+	Runs       []ScheduleRunModel `tfsdk:"runs"`
+}
+
+type ScheduleRunModel struct {
+	ID           types.String `tfsdk:"id"`
+	Title        types.String `tfsdk:"title"`
+	Description  types.String `tfsdk:"description"`
+	Status       types.String `tfsdk:"status"`
+	LogPath      types.String `tfsdk:"log_path"`
+	CreatedAt    types.String `tfsdk:"created_at"`
+	StartedAt    types.String `tfsdk:"started_at"`
+	FinishedAt   types.String `tfsdk:"finished_at"`
+	ErrorMessage types.String `tfsdk:"error_message"`
+}
+
+func (d *ScheduleRunsDataSource) Metadata(_ context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_schedule_runs"
+}
+
+func (d *ScheduleRunsDataSource) Schema(_ context.Context, _ datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Description: "Fetches the run history of a Dokploy schedule.",
+		Attributes: map[string]schema.Attribute{
+			"schedule_id": schema.StringAttribute{
+				Required:    true,
+				Description: "ID of the schedule.",
+			},
+			"runs": schema.ListNestedAttribute{
+				Computed:    true,
+				Description: "List of schedule runs.",
+				NestedObject: schema.NestedAttributeObject{
+					Attributes: map[string]schema.Attribute{
+						"id": schema.StringAttribute{
+							Computed:    true,
+							Description: "The unique identifier of the run.",
+						},
+						"title": schema.StringAttribute{
+							Computed:    true,
+							Description: "Title of the run.",
+						},
+						"description": schema.StringAttribute{
+							Computed:    true,
+							Description: "Description of the run.",
+						},
+						"status": schema.StringAttribute{
+							Computed:    true,
+							Description: "Status of the run: running, done, error.",
+						},
+						"log_path": schema.StringAttribute{
+							Computed:    true,
+							Description: "Path to the run log file.",
+						},
+						"created_at": schema.StringAttribute{
+							Computed:    true,
+							Description: "Timestamp when the run was created.",
+						},
+						"started_at": schema.StringAttribute{
+							Computed:    true,
+							Description: "Timestamp when the run started.",
+						},
+						"finished_at": schema.StringAttribute{
+							Computed:    true,
+							Description: "Timestamp when the run finished (null if still running).",
+						},
+						"error_message": schema.StringAttribute{
+							Computed:    true,
+							Description: "Error message if the run failed.",
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func (d *ScheduleRunsDataSource) Configure(_ context.Context, req datasource.ConfigureRequest, resp *datasource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+	client, ok := req.ProviderData.(*client.DokployClient)
+	if !ok {
+		resp.Diagnostics.AddError("Unexpected Data Source Configure Type", fmt.Sprintf("Expected *client.DokployClient, got: %T", req.ProviderData))
+		return
+	}
+	d.client = client
+}
+
+func (d *ScheduleRunsDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	var data ScheduleRunsDataSourceModel
+	diags := req.Config.Get(ctx, &data)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	runs, err := d.client.ListScheduleRuns(data.ScheduleID.ValueString())
+	if err != nil {
+		resp.Diagnostics.AddError("Unable to List Schedule Runs", err.Error())
+		return
+	}
+
+	data.Runs = make([]ScheduleRunModel, len(runs))
+	for i, run := range runs {
+		data.Runs[i] = ScheduleRunModel{
+			ID:           types.StringValue(run.ID),
+			Title:        types.StringValue(run.Title),
+			Description:  types.StringValue(run.Description),
+			Status:       types.StringValue(run.Status),
+			LogPath:      types.StringValue(run.LogPath),
+			CreatedAt:    types.StringValue(run.CreatedAt),
+			StartedAt:    types.StringValue(run.StartedAt),
+			FinishedAt:   types.StringPointerValue(run.FinishedAt),
+			ErrorMessage: types.StringPointerValue(run.ErrorMessage),
+		}
+	}
+
+	diags = resp.State.Set(ctx, &data)
+	resp.Diagnostics.Append(diags...)
+}
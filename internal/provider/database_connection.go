@@ -0,0 +1,220 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+	"net"
+	"net/url"
+	"strconv"
+
+	"github.com/ahmedali6/terraform-provider-dokploy/internal/client"
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/int64planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+// databaseInternalPorts maps each database type to the default port it
+// listens on inside the Docker network.
+var databaseInternalPorts = map[string]int64{
+	"postgres": 5432,
+	"mysql":    3306,
+	"mariadb":  3306,
+	"mongo":    27017,
+	"redis":    6379,
+}
+
+// databaseInternalPort returns the internal port reported by Dokploy, or the
+// default port of the database type when none is reported.
+func databaseInternalPort(dbType string, reported int64) int64 {
+	if reported != 0 {
+		return reported
+	}
+	return databaseInternalPorts[dbType]
+}
+
+// databaseURLSchemes maps each database type to its connection URL scheme.
+var databaseURLSchemes = map[string]string{
+	"postgres": "postgres",
+	"mysql":    "mysql",
+	"mariadb":  "mysql",
+	"mongo":    "mongodb",
+	"redis":    "redis",
+}
+
+// databaseConnectionSchemaAttributes returns the computed connection attributes
+// shared by all database resources.
+func databaseConnectionSchemaAttributes() map[string]schema.Attribute {
+	return map[string]schema.Attribute{
+		"internal_host": schema.StringAttribute{
+			Computed:    true,
+			Sensitive:   true,
+			Description: "Hostname of the database inside the Docker network.",
+			PlanModifiers: []planmodifier.String{
+				stringplanmodifier.UseStateForUnknown(),
+			},
+		},
+		"internal_port": schema.Int64Attribute{
+			Computed:    true,
+			Sensitive:   true,
+			Description: "Port the database listens on inside the Docker network.",
+			PlanModifiers: []planmodifier.Int64{
+				int64planmodifier.UseStateForUnknown(),
+			},
+		},
+		"internal_connection_url": schema.StringAttribute{
+			Computed:    true,
+			Sensitive:   true,
+			Description: "Connection URL for services in the same Docker network. The password is omitted when it is write-only.",
+			PlanModifiers: []planmodifier.String{
+				connectionURLModifier(),
+			},
+		},
+		"external_connection_url": schema.StringAttribute{
+			Computed:    true,
+			Sensitive:   true,
+			Description: "Connection URL through external_port, using the IP address of the database's server, or the Dokploy host when no server is set. Null when external_port is not set.",
+			PlanModifiers: []planmodifier.String{
+				connectionURLModifier(),
+			},
+		},
+	}
+}
+
+// databaseConnectionInfo describes a database as needed to build its
+// connection attributes.
+type databaseConnectionInfo struct {
+	Type         string
+	AppName      string
+	User         string
+	Password     string
+	DatabaseName string
+	ServerID     string
+	ExternalPort int
+	InternalPort int64 // As reported by Dokploy; 0 for the type's default
+}
+
+// databaseConnection holds the computed connection attributes of a database.
+type databaseConnection struct {
+	InternalHost          types.String
+	InternalPort          types.Int64
+	InternalConnectionURL types.String
+	ExternalConnectionURL types.String
+}
+
+// resolve builds the connection attributes. The external host is only looked
+// up when the database has an external port.
+func (i databaseConnectionInfo) resolve(c *client.DokployClient) (*databaseConnection, error) {
+	internalPort := databaseInternalPort(i.Type, i.InternalPort)
+	conn := &databaseConnection{
+		InternalHost:          types.StringValue(i.AppName),
+		InternalPort:          types.Int64Value(internalPort),
+		InternalConnectionURL: types.StringValue(i.url(i.AppName, internalPort)),
+		ExternalConnectionURL: types.StringNull(),
+	}
+
+	if i.ExternalPort == 0 {
+		return conn, nil
+	}
+
+	host, err := databaseExternalHost(c, i.ServerID)
+	if err != nil {
+		return nil, err
+	}
+	conn.ExternalConnectionURL = types.StringValue(i.url(host, int64(i.ExternalPort)))
+	return conn, nil
+}
+
+func (i databaseConnectionInfo) url(host string, port int64) string {
+	u := url.URL{
+		Scheme: databaseURLSchemes[i.Type],
+		Host:   net.JoinHostPort(host, strconv.FormatInt(port, 10)),
+	}
+	if i.Password != "" {
+		u.User = url.UserPassword(i.User, i.Password)
+	} else if i.User != "" {
+		u.User = url.User(i.User)
+	}
+	if i.DatabaseName != "" {
+		u.Path = "/" + i.DatabaseName
+	}
+	return u.String()
+}
+
+// databaseExternalHost returns the public host of the server a database runs
+// on: the remote server's IP address, or the Dokploy host itself.
+func databaseExternalHost(c *client.DokployClient, serverID string) (string, error) {
+	if serverID != "" {
+		server, err := c.GetServer(serverID)
+		if err != nil {
+			return "", fmt.Errorf("failed to read server %s: %w", serverID, err)
+		}
+		return server.IPAddress, nil
+	}
+
+	u, err := url.Parse(c.BaseURL)
+	if err != nil {
+		return "", fmt.Errorf("failed to parse Dokploy host: %w", err)
+	}
+	return u.Hostname(), nil
+}
+
+// connectionURLInputs lists the attributes the connection URLs are built
+// from. Attributes missing from a resource's schema are skipped.
+var connectionURLInputs = []string{
+	"app_name",
+	"app_name_prefix",
+	"database_name",
+	"database_user",
+	"database_password",
+	"external_port",
+	"server_id",
+}
+
+// connectionURLModifier keeps a connection URL from state unless one of the
+// attributes it is built from changes, in which case it is left unknown to be
+// recomputed on apply.
+func connectionURLModifier() planmodifier.String {
+	return connectionURLPlanModifier{}
+}
+
+type connectionURLPlanModifier struct{}
+
+func (m connectionURLPlanModifier) Description(_ context.Context) string {
+	return "Uses the prior state value unless an attribute the connection URL is built from changes."
+}
+
+func (m connectionURLPlanModifier) MarkdownDescription(ctx context.Context) string {
+	return m.Description(ctx)
+}
+
+func (m connectionURLPlanModifier) PlanModifyString(ctx context.Context, req planmodifier.StringRequest, resp *planmodifier.StringResponse) {
+	// Resource is being created or destroyed.
+	if req.State.Raw.IsNull() || req.Plan.Raw.IsNull() {
+		return
+	}
+	if !req.PlanValue.IsUnknown() {
+		return
+	}
+
+	attributes := req.Plan.Schema.GetAttributes()
+	for _, name := range connectionURLInputs {
+		if _, ok := attributes[name]; !ok {
+			continue
+		}
+		var planValue, stateValue attr.Value
+		resp.Diagnostics.Append(req.Plan.GetAttribute(ctx, path.Root(name), &planValue)...)
+		resp.Diagnostics.Append(req.State.GetAttribute(ctx, path.Root(name), &stateValue)...)
+		if resp.Diagnostics.HasError() {
+			return
+		}
+		if !planValue.Equal(stateValue) {
+			return
+		}
+	}
+
+	resp.PlanValue = req.StateValue
+}
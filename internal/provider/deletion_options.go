@@ -0,0 +1,63 @@
+package provider
+
+import (
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/booldefault"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+// DeletionOptionsModel holds the options that control how a stateful service
+// is destroyed. It is embedded in the application, compose and database
+// models. The options are only stored in state; changing them makes no API
+// call.
+type DeletionOptionsModel struct {
+	DeleteVolumes      types.Bool `tfsdk:"delete_volumes"`
+	DeletionProtection types.Bool `tfsdk:"deletion_protection"`
+}
+
+// deletionOptionsSchemaAttributes returns the delete_volumes and
+// deletion_protection attributes. volumes describes the volumes removed with
+// the resource.
+func deletionOptionsSchemaAttributes(volumes string) map[string]schema.Attribute {
+	return map[string]schema.Attribute{
+		"delete_volumes": schema.BoolAttribute{
+			Optional:    true,
+			Computed:    true,
+			Default:     booldefault.StaticBool(false),
+			Description: fmt.Sprintf("Whether to remove %s when the resource is destroyed. Default: false.", volumes),
+		},
+		"deletion_protection": schema.BoolAttribute{
+			Optional:    true,
+			Computed:    true,
+			Default:     booldefault.StaticBool(false),
+			Description: "Whether destroying the resource fails. It must be set to false and applied before the resource can be destroyed. Default: false.",
+		},
+	}
+}
+
+// setDefaults sets options missing from state, such as after import, to
+// their defaults.
+func (m *DeletionOptionsModel) setDefaults() {
+	if m.DeleteVolumes.IsNull() {
+		m.DeleteVolumes = types.BoolValue(false)
+	}
+	if m.DeletionProtection.IsNull() {
+		m.DeletionProtection = types.BoolValue(false)
+	}
+}
+
+// checkDeletionProtection adds an error and reports false when deletion
+// protection is enabled. kind and name identify the resource in the error.
+func (m *DeletionOptionsModel) checkDeletionProtection(kind, name string, diags *diag.Diagnostics) bool {
+	if m.DeletionProtection.ValueBool() {
+		diags.AddError(
+			"Deletion Protection Enabled",
+			fmt.Sprintf("%s %q cannot be destroyed while deletion_protection is true. Set deletion_protection = false and apply before destroying it.", kind, name),
+		)
+		return false
+	}
+	return true
+}
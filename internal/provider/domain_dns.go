@@ -0,0 +1,80 @@
+package provider
+
+import (
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+)
+
+// domainServerIP returns the public IP the domain should resolve to: that of
+// the remote server hosting the associated application or compose stack, or
+// of the Dokploy host.
+func (r *DomainResource) domainServerIP(m *DomainResourceModel) (string, error) {
+	var serverID string
+	switch {
+	case !m.ApplicationID.IsNull():
+		app, err := r.client.GetApplication(m.ApplicationID.ValueString())
+		if err != nil {
+			return "", fmt.Errorf("failed to fetch application: %w", err)
+		}
+		serverID = app.ServerID
+	case !m.ComposeID.IsNull():
+		comp, err := r.client.GetCompose(m.ComposeID.ValueString())
+		if err != nil {
+			return "", fmt.Errorf("failed to fetch compose: %w", err)
+		}
+		serverID = comp.ServerID
+	}
+
+	if serverID != "" {
+		server, err := r.client.GetServer(serverID)
+		if err != nil {
+			return "", fmt.Errorf("failed to fetch server: %w", err)
+		}
+		return server.IPAddress, nil
+	}
+
+	settings, err := r.client.GetWebServerSettings()
+	if err != nil {
+		return "", fmt.Errorf("failed to fetch web server settings: %w", err)
+	}
+	return settings.ServerIP, nil
+}
+
+// checkDNS validates that the domain's host resolves to the server hosting it.
+// A mismatch is reported as an error when fail is true, and as a warning
+// otherwise. Failures to run the check are always warnings, so an unreachable
+// resolver or API does not block the plan.
+func (r *DomainResource) checkDNS(m *DomainResourceModel, fail bool, diags *diag.Diagnostics) {
+	report := diags.AddAttributeWarning
+	if fail {
+		report = diags.AddAttributeError
+	}
+	host := m.Host.ValueString()
+
+	serverIP, err := r.domainServerIP(m)
+	if err != nil {
+		diags.AddAttributeWarning(path.Root("host"), "DNS Validation Failed", fmt.Sprintf("Could not determine the server IP for %s: %s", host, err))
+		return
+	}
+
+	result, err := r.client.ValidateDomain(host, serverIP)
+	if err != nil {
+		diags.AddAttributeWarning(path.Root("host"), "DNS Validation Failed", fmt.Sprintf("Could not validate %s: %s", host, err))
+		return
+	}
+	if result.IsValid {
+		return
+	}
+
+	resolved := result.ResolvedIP
+	if resolved == "" {
+		resolved = "no addresses"
+	}
+	detail := fmt.Sprintf("%s resolves to %s, expected %s.", host, resolved, serverIP)
+	if result.Error != "" {
+		detail += " " + result.Error
+	}
+	report(path.Root("host"), "DNS Mismatch", detail+" Certificate issuance will fail until DNS points at the server.")
+}
@@ -0,0 +1,170 @@
+package provider
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"strings"
+
+	"github.com/ahmedali6/terraform-provider-dokploy/internal/client"
+	"github.com/hashicorp/terraform-plugin-framework-validators/int64validator"
+	"github.com/hashicorp/terraform-plugin-framework/ephemeral"
+	"github.com/hashicorp/terraform-plugin-framework/ephemeral/schema"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+var _ ephemeral.EphemeralResource = &ApiKeyEphemeralResource{}
+var _ ephemeral.EphemeralResourceWithConfigure = &ApiKeyEphemeralResource{}
+var _ ephemeral.EphemeralResourceWithClose = &ApiKeyEphemeralResource{}
+
+// apiKeyPrivateKey is the private data key holding the ID of the API key
+// created in Open, so Close can delete it.
+const apiKeyPrivateKey = "api_key_id"
+
+func NewApiKeyEphemeralResource() ephemeral.EphemeralResource {
+	return &ApiKeyEphemeralResource{}
+}
+
+type ApiKeyEphemeralResource struct {
+	client *client.DokployClient
+}
+
+type ApiKeyEphemeralResourceModel struct {
+	ID             types.String `tfsdk:"id"`
+	Name           types.String `tfsdk:"name"`
+	OrganizationID types.String `tfsdk:"organization_id"`
+	ExpiresIn      types.Int64  `tfsdk:"expires_in"`
+	Key            types.String `tfsdk:"key"`
+	Start          types.String `tfsdk:"start"`
+	ExpiresAt      types.String `tfsdk:"expires_at"`
+}
+
+func (e *ApiKeyEphemeralResource) Metadata(_ context.Context, req ephemeral.MetadataRequest, resp *ephemeral.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_api_key"
+}
+
+func (e *ApiKeyEphemeralResource) Schema(_ context.Context, _ ephemeral.SchemaRequest, resp *ephemeral.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Description: "Creates a short-lived API key in Dokploy for the duration of a Terraform run. The key is deleted when Terraform closes the ephemeral resource and is never stored in state.",
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				Computed:    true,
+				Description: "Unique identifier for the API key.",
+			},
+			"name": schema.StringAttribute{
+				Required:    true,
+				Description: "Name of the API key.",
+			},
+			"organization_id": schema.StringAttribute{
+				Optional:    true,
+				Computed:    true,
+				Description: "The organization ID to associate the API key with. If not specified, uses the current organization.",
+			},
+			"expires_in": schema.Int64Attribute{
+				Required:    true,
+				Description: "Time in seconds until the API key expires. Minimum is 86400 (1 day).",
+				Validators: []validator.Int64{
+					int64validator.AtLeast(86400),
+				},
+			},
+			"key": schema.StringAttribute{
+				Computed:    true,
+				Sensitive:   true,
+				Description: "The API key value.",
+			},
+			"start": schema.StringAttribute{
+				Computed:    true,
+				Description: "The first few characters of the API key for identification.",
+			},
+			"expires_at": schema.StringAttribute{
+				Computed:    true,
+				Description: "The timestamp when the API key expires.",
+			},
+		},
+	}
+}
+
+func (e *ApiKeyEphemeralResource) Configure(_ context.Context, req ephemeral.ConfigureRequest, resp *ephemeral.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+	client, ok := req.ProviderData.(*client.DokployClient)
+	if !ok {
+		resp.Diagnostics.AddError("Unexpected Ephemeral Resource Configure Type", fmt.Sprintf("Expected *client.DokployClient, got: %T", req.ProviderData))
+		return
+	}
+	e.client = client
+}
+
+func (e *ApiKeyEphemeralResource) Open(ctx context.Context, req ephemeral.OpenRequest, resp *ephemeral.OpenResponse) {
+	var data ApiKeyEphemeralResourceModel
+	resp.Diagnostics.Append(req.Config.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Get organization ID - if not provided, get from current user
+	orgID := data.OrganizationID.ValueString()
+	if orgID == "" {
+		user, err := e.client.GetUser()
+		if err != nil {
+			resp.Diagnostics.AddError("Error getting current user", err.Error())
+			return
+		}
+		orgID = user.OrganizationID
+	}
+
+	expiresIn := data.ExpiresIn.ValueInt64()
+	apiKey, err := e.client.CreateApiKey(client.ApiKeyCreateInput{
+		Name: data.Name.ValueString(),
+		Metadata: map[string]string{
+			"organizationId": orgID,
+		},
+		ExpiresIn: &expiresIn,
+	})
+	if err != nil {
+		resp.Diagnostics.AddError("Error creating API key", err.Error())
+		return
+	}
+
+	data.ID = types.StringValue(apiKey.ID)
+	data.OrganizationID = types.StringValue(orgID)
+	data.Key = types.StringValue(apiKey.Key)
+	data.Start = types.StringValue(apiKey.Start)
+	if apiKey.ExpiresAt != nil {
+		data.ExpiresAt = types.StringValue(*apiKey.ExpiresAt)
+	} else {
+		data.ExpiresAt = types.StringNull()
+	}
+
+	privateID, err := json.Marshal(apiKey.ID)
+	if err != nil {
+		resp.Diagnostics.AddError("Error storing API key ID", err.Error())
+		return
+	}
+	resp.Diagnostics.Append(resp.Private.SetKey(ctx, apiKeyPrivateKey, privateID)...)
+	resp.Diagnostics.Append(resp.Result.Set(ctx, &data)...)
+}
+
+func (e *ApiKeyEphemeralResource) Close(ctx context.Context, req ephemeral.CloseRequest, resp *ephemeral.CloseResponse) {
+	privateID, diags := req.Private.GetKey(ctx, apiKeyPrivateKey)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() || len(privateID) == 0 {
+		return
+	}
+
+	var id string
+	if err := json.Unmarshal(privateID, &id); err != nil {
+		resp.Diagnostics.AddError("Error reading API key ID", err.Error())
+		return
+	}
+
+	err := e.client.DeleteApiKey(id)
+	if err != nil {
+		if strings.Contains(err.Error(), "not found") || strings.Contains(err.Error(), "Not Found") || strings.Contains(err.Error(), "404") {
+			return
+		}
+		resp.Diagnostics.AddError("Error deleting API key", err.Error())
+	}
+}
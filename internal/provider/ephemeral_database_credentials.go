@@ -0,0 +1,135 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/ahmedali6/terraform-provider-dokploy/internal/client"
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/ephemeral"
+	"github.com/hashicorp/terraform-plugin-framework/ephemeral/schema"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+var _ ephemeral.EphemeralResource = &DatabaseCredentialsEphemeralResource{}
+var _ ephemeral.EphemeralResourceWithConfigure = &DatabaseCredentialsEphemeralResource{}
+
+func NewDatabaseCredentialsEphemeralResource() ephemeral.EphemeralResource {
+	return &DatabaseCredentialsEphemeralResource{}
+}
+
+type DatabaseCredentialsEphemeralResource struct {
+	client *client.DokployClient
+}
+
+type DatabaseCredentialsEphemeralResourceModel struct {
+	DatabaseID   types.String `tfsdk:"database_id"`
+	DatabaseType types.String `tfsdk:"database_type"`
+	Host         types.String `tfsdk:"host"`
+	InternalPort types.Int64  `tfsdk:"internal_port"`
+	ExternalPort types.Int64  `tfsdk:"external_port"`
+	DatabaseName types.String `tfsdk:"database_name"`
+	Username     types.String `tfsdk:"username"`
+	Password     types.String `tfsdk:"password"`
+	RootPassword types.String `tfsdk:"root_password"`
+}
+
+func (e *DatabaseCredentialsEphemeralResource) Metadata(_ context.Context, req ephemeral.MetadataRequest, resp *ephemeral.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_database_credentials"
+}
+
+func (e *DatabaseCredentialsEphemeralResource) Schema(_ context.Context, _ ephemeral.SchemaRequest, resp *ephemeral.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Description: "Reads the connection details of a Dokploy database without storing them in state.",
+		Attributes: map[string]schema.Attribute{
+			"database_id": schema.StringAttribute{
+				Required:    true,
+				Description: "The ID of the database.",
+			},
+			"database_type": schema.StringAttribute{
+				Required:    true,
+				Description: "The type of the database. One of 'postgres', 'mysql', 'mariadb', 'mongo' or 'redis'.",
+				Validators: []validator.String{
+					stringvalidator.OneOf("postgres", "mysql", "mariadb", "mongo", "redis"),
+				},
+			},
+			"host": schema.StringAttribute{
+				Computed:    true,
+				Description: "Hostname of the database inside the Docker network (the app name).",
+			},
+			"internal_port": schema.Int64Attribute{
+				Computed:    true,
+				Description: "Port the database listens on inside the Docker network.",
+			},
+			"external_port": schema.Int64Attribute{
+				Computed:    true,
+				Description: "Port the database is exposed on the host, if any.",
+			},
+			"database_name": schema.StringAttribute{
+				Computed:    true,
+				Description: "Name of the database. Not set for MongoDB and Redis.",
+			},
+			"username": schema.StringAttribute{
+				Computed:    true,
+				Description: "Database user. For Redis this is always 'default'.",
+			},
+			"password": schema.StringAttribute{
+				Computed:    true,
+				Sensitive:   true,
+				Description: "Database password.",
+			},
+			"root_password": schema.StringAttribute{
+				Computed:    true,
+				Sensitive:   true,
+				Description: "Root password. Only set for MySQL and MariaDB.",
+			},
+		},
+	}
+}
+
+func (e *DatabaseCredentialsEphemeralResource) Configure(_ context.Context, req ephemeral.ConfigureRequest, resp *ephemeral.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+	client, ok := req.ProviderData.(*client.DokployClient)
+	if !ok {
+		resp.Diagnostics.AddError("Unexpected Ephemeral Resource Configure Type", fmt.Sprintf("Expected *client.DokployClient, got: %T", req.ProviderData))
+		return
+	}
+	e.client = client
+}
+
+func (e *DatabaseCredentialsEphemeralResource) Open(ctx context.Context, req ephemeral.OpenRequest, resp *ephemeral.OpenResponse) {
+	var data DatabaseCredentialsEphemeralResourceModel
+	resp.Diagnostics.Append(req.Config.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	dbType := data.DatabaseType.ValueString()
+	db, err := e.client.GetDatabase(data.DatabaseID.ValueString(), dbType)
+	if err != nil {
+		resp.Diagnostics.AddError("Error reading database credentials", err.Error())
+		return
+	}
+
+	username := db.DatabaseUser
+	if dbType == "redis" {
+		username = "default"
+	}
+
+	data.Host = types.StringValue(db.AppName)
+	data.InternalPort = types.Int64Value(databaseInternalPort(dbType, db.InternalPort))
+	if db.ExternalPort != 0 {
+		data.ExternalPort = types.Int64Value(db.ExternalPort)
+	} else {
+		data.ExternalPort = types.Int64Null()
+	}
+	data.DatabaseName = optionalString(db.DatabaseName)
+	data.Username = optionalString(username)
+	data.Password = types.StringValue(db.Password)
+	data.RootPassword = optionalString(db.RootPassword)
+
+	resp.Diagnostics.Append(resp.Result.Set(ctx, &data)...)
+}
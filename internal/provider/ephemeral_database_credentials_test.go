@@ -0,0 +1,81 @@
+package provider
+
+import (
+	"fmt"
+	"os"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/knownvalue"
+	"github.com/hashicorp/terraform-plugin-testing/statecheck"
+	"github.com/hashicorp/terraform-plugin-testing/tfjsonpath"
+	"github.com/hashicorp/terraform-plugin-testing/tfversion"
+)
+
+func TestAccDatabaseCredentialsEphemeralResource(t *testing.T) {
+	host := os.Getenv("DOKPLOY_HOST")
+	apiKey := os.Getenv("DOKPLOY_API_KEY")
+
+	if host == "" || apiKey == "" {
+		t.Skip("DOKPLOY_HOST and DOKPLOY_API_KEY must be set for acceptance tests")
+	}
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactoriesWithEcho,
+		TerraformVersionChecks: []tfversion.TerraformVersionCheck{
+			tfversion.SkipBelow(tfversion.Version1_10_0),
+		},
+		Steps: []resource.TestStep{
+			{
+				Config: testAccDatabaseCredentialsEphemeralResourceConfig(),
+				ConfigStateChecks: []statecheck.StateCheck{
+					statecheck.ExpectKnownValue("echo.test", tfjsonpath.New("data").AtMapKey("host"), knownvalue.NotNull()),
+					statecheck.ExpectKnownValue("echo.test", tfjsonpath.New("data").AtMapKey("internal_port"), knownvalue.Int64Exact(5432)),
+					statecheck.ExpectKnownValue("echo.test", tfjsonpath.New("data").AtMapKey("database_name"), knownvalue.StringExact("testdb")),
+					statecheck.ExpectKnownValue("echo.test", tfjsonpath.New("data").AtMapKey("username"), knownvalue.StringExact("testuser")),
+					statecheck.ExpectKnownValue("echo.test", tfjsonpath.New("data").AtMapKey("password"), knownvalue.StringExact("test_postgres_password_123")),
+				},
+			},
+		},
+	})
+}
+
+func testAccDatabaseCredentialsEphemeralResourceConfig() string {
+	return fmt.Sprintf(`
+provider "dokploy" {
+  host    = "%s"
+  api_key = "%s"
+}
+
+resource "dokploy_project" "test" {
+  name        = "test-db-credentials-project"
+  description = "Test project for database credentials tests"
+}
+
+resource "dokploy_environment" "test" {
+  project_id = dokploy_project.test.id
+  name       = "test-db-credentials-env"
+}
+
+resource "dokploy_postgres" "test" {
+  name              = "test-postgres-creds"
+  app_name          = "testpgcreds"
+  database_name     = "testdb"
+  database_user     = "testuser"
+  database_password = "test_postgres_password_123"
+  environment_id    = dokploy_environment.test.id
+}
+
+ephemeral "dokploy_database_credentials" "test" {
+  database_id   = dokploy_postgres.test.id
+  database_type = "postgres"
+}
+
+provider "echo" {
+  data = ephemeral.dokploy_database_credentials.test
+}
+
+resource "echo" "test" {}
+`, os.Getenv("DOKPLOY_HOST"), os.Getenv("DOKPLOY_API_KEY"))
+}
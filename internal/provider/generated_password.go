@@ -0,0 +1,100 @@
+package provider
+
+import (
+	"context"
+	"crypto/rand"
+	"math/big"
+
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+const (
+	generatedPasswordLength = 32
+	// Alphanumeric only, so generated passwords can be embedded in
+	// connection URLs without escaping.
+	generatedPasswordAlphabet = "abcdefghijklmnopqrstuvwxyzABCDEFGHIJKLMNOPQRSTUVWXYZ0123456789"
+)
+
+// generatePassword returns a random alphanumeric password.
+func generatePassword() (string, error) {
+	b := make([]byte, generatedPasswordLength)
+	max := big.NewInt(int64(len(generatedPasswordAlphabet)))
+	for i := range b {
+		n, err := rand.Int(rand.Reader, max)
+		if err != nil {
+			return "", err
+		}
+		b[i] = generatedPasswordAlphabet[n.Int64()]
+	}
+	return string(b), nil
+}
+
+// resolvePassword fills an unknown planned password with a generated one.
+// Unknown means the password is provider-managed and either being created
+// or rotated; see generatedPasswordModifier.
+func resolvePassword(v *types.String) error {
+	if !v.IsUnknown() {
+		return nil
+	}
+	password, err := generatePassword()
+	if err != nil {
+		return err
+	}
+	*v = types.StringValue(password)
+	return nil
+}
+
+// generatedPasswordModifier plans a password attribute that the provider
+// generates when it is not set in configuration. The generated value is kept
+// across plans and only regenerated when rotate_password_trigger changes.
+// When the matching write-only attribute is set, no password is stored.
+func generatedPasswordModifier(writeOnlyAttr string) planmodifier.String {
+	return generatedPasswordPlanModifier{writeOnlyAttr: writeOnlyAttr}
+}
+
+type generatedPasswordPlanModifier struct {
+	writeOnlyAttr string
+}
+
+func (m generatedPasswordPlanModifier) Description(_ context.Context) string {
+	return "Generates a password when none is configured and regenerates it when rotate_password_trigger changes."
+}
+
+func (m generatedPasswordPlanModifier) MarkdownDescription(ctx context.Context) string {
+	return m.Description(ctx)
+}
+
+func (m generatedPasswordPlanModifier) PlanModifyString(ctx context.Context, req planmodifier.StringRequest, resp *planmodifier.StringResponse) {
+	// Password set explicitly in configuration.
+	if !req.ConfigValue.IsNull() {
+		return
+	}
+
+	var writeOnly types.String
+	resp.Diagnostics.Append(req.Config.GetAttribute(ctx, path.Root(m.writeOnlyAttr), &writeOnly)...)
+	if !writeOnly.IsNull() {
+		resp.PlanValue = types.StringNull()
+		return
+	}
+
+	// Resource is being created or destroyed.
+	if req.State.Raw.IsNull() || req.Plan.Raw.IsNull() {
+		return
+	}
+
+	var planTrigger, stateTrigger types.String
+	resp.Diagnostics.Append(req.Plan.GetAttribute(ctx, path.Root("rotate_password_trigger"), &planTrigger)...)
+	resp.Diagnostics.Append(req.State.GetAttribute(ctx, path.Root("rotate_password_trigger"), &stateTrigger)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	if !planTrigger.Equal(stateTrigger) {
+		resp.PlanValue = types.StringUnknown()
+		return
+	}
+
+	resp.PlanValue = req.StateValue
+}
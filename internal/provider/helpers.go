@@ -0,0 +1,38 @@
+package provider
+
+import (
+	"context"
+
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/tfsdk"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+// writeOnlyValue returns the write-only attribute at p when it is set in config,
+// otherwise the given fallback. Write-only values are never present in the plan
+// or state, so they can only be read from config.
+func writeOnlyValue(ctx context.Context, config tfsdk.Config, p path.Path, fallback types.String, diags *diag.Diagnostics) string {
+	var wo types.String
+	diags.Append(config.GetAttribute(ctx, p, &wo)...)
+	if !wo.IsNull() && !wo.IsUnknown() {
+		return wo.ValueString()
+	}
+	return fallback.ValueString()
+}
+
+// optionalString returns a null string for empty values.
+func optionalString(s string) types.String {
+	if s == "" {
+		return types.StringNull()
+	}
+	return types.StringValue(s)
+}
+
+// optionalStringPointer returns a null string for nil or empty values.
+func optionalStringPointer(s *string) types.String {
+	if s == nil {
+		return types.StringNull()
+	}
+	return optionalString(*s)
+}
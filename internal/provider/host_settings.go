@@ -0,0 +1,120 @@
+package provider
+
+import (
+	"github.com/ahmedali6/terraform-provider-dokploy/internal/client"
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/boolplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+// HostSettingsModel holds the settings shared by the Dokploy host and remote
+// servers. It is embedded in the web server and server settings models.
+type HostSettingsModel struct {
+	EnableDockerCleanup types.Bool `tfsdk:"enable_docker_cleanup"`
+	TraefikDashboard    types.Bool `tfsdk:"traefik_dashboard"`
+	TraefikConfig       YAMLValue  `tfsdk:"traefik_config"`
+	MiddlewareConfig    YAMLValue  `tfsdk:"middleware_config"`
+}
+
+// hostSettingsSchemaAttributes returns the attributes shared by the web
+// server and server settings resources. All of them are optional; values
+// that are not configured are left as they are and tracked for drift.
+func hostSettingsSchemaAttributes() map[string]schema.Attribute {
+	return map[string]schema.Attribute{
+		"enable_docker_cleanup": schema.BoolAttribute{
+			Optional:    true,
+			Computed:    true,
+			Description: "Whether unused Docker images, containers and volumes are cleaned up daily.",
+			PlanModifiers: []planmodifier.Bool{
+				boolplanmodifier.UseStateForUnknown(),
+			},
+		},
+		"traefik_dashboard": schema.BoolAttribute{
+			Optional:    true,
+			Computed:    true,
+			Description: "Whether the Traefik dashboard is exposed on port 8080. Toggling it restarts Traefik.",
+			PlanModifiers: []planmodifier.Bool{
+				boolplanmodifier.UseStateForUnknown(),
+			},
+		},
+		"traefik_config": schema.StringAttribute{
+			CustomType:  YAMLType{},
+			Optional:    true,
+			Computed:    true,
+			Description: "Content of the main Traefik configuration (traefik.yml).",
+			PlanModifiers: []planmodifier.String{
+				stringplanmodifier.UseStateForUnknown(),
+			},
+		},
+		"middleware_config": schema.StringAttribute{
+			CustomType:  YAMLType{},
+			Optional:    true,
+			Computed:    true,
+			Description: "Content of the Traefik middleware dynamic configuration (dynamic/middlewares.yml).",
+			PlanModifiers: []planmodifier.String{
+				stringplanmodifier.UseStateForUnknown(),
+			},
+		},
+	}
+}
+
+// settingChanged reports whether a configured setting differs from its prior
+// value. Settings that are not configured are unknown in the plan and left
+// as they are.
+func settingChanged(planned, prior attr.Value) bool {
+	return !planned.IsUnknown() && !planned.Equal(prior)
+}
+
+// apply pushes the configured settings that differ from prior to the Dokploy
+// host, or to the remote server when serverID is set. prior is empty on create.
+func (m *HostSettingsModel) apply(c *client.DokployClient, serverID string, prior *HostSettingsModel) error {
+	if settingChanged(m.TraefikConfig, prior.TraefikConfig) {
+		if err := c.UpdateTraefikFile(client.TraefikMainConfigPath, m.TraefikConfig.ValueString(), serverID); err != nil {
+			return err
+		}
+	}
+	if settingChanged(m.MiddlewareConfig, prior.MiddlewareConfig) {
+		if err := c.UpdateTraefikFile(client.TraefikMiddlewareConfigPath, m.MiddlewareConfig.ValueString(), serverID); err != nil {
+			return err
+		}
+	}
+	if settingChanged(m.EnableDockerCleanup, prior.EnableDockerCleanup) {
+		if err := c.UpdateDockerCleanup(m.EnableDockerCleanup.ValueBool(), serverID); err != nil {
+			return err
+		}
+	}
+	// The dashboard is toggled last as it restarts Traefik, which then picks
+	// up the new configuration files.
+	if settingChanged(m.TraefikDashboard, prior.TraefikDashboard) {
+		if err := c.ToggleTraefikDashboard(m.TraefikDashboard.ValueBool(), serverID); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// read refreshes the Traefik settings from the Dokploy host, or from the
+// remote server when serverID is set. Docker cleanup is read by the caller
+// as it comes from a different endpoint for the host and for servers.
+func (m *HostSettingsModel) read(c *client.DokployClient, serverID string) error {
+	traefikConfig, err := c.ReadTraefikFile(client.TraefikMainConfigPath, serverID)
+	if err != nil {
+		return err
+	}
+	middlewareConfig, err := c.ReadTraefikFile(client.TraefikMiddlewareConfigPath, serverID)
+	if err != nil {
+		return err
+	}
+	dashboard, err := c.IsTraefikDashboardEnabled(serverID)
+	if err != nil {
+		return err
+	}
+
+	m.TraefikConfig = NewYAMLValue(traefikConfig)
+	m.MiddlewareConfig = NewYAMLValue(middlewareConfig)
+	m.TraefikDashboard = types.BoolValue(dashboard)
+	return nil
+}
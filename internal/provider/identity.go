@@ -0,0 +1,64 @@
+package provider
+
+import (
+	"context"
+
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/identityschema"
+	"github.com/hashicorp/terraform-plugin-framework/tfsdk"
+)
+
+// idIdentitySchema is the identity schema of resources identified by their
+// ID alone.
+func idIdentitySchema() identityschema.Schema {
+	return identityschema.Schema{
+		Attributes: map[string]identityschema.Attribute{
+			"id": identityschema.StringAttribute{
+				RequiredForImport: true,
+				Description:       "The ID of the resource.",
+			},
+		},
+	}
+}
+
+// setIdentity sets the identity of a resource from its state. Identity
+// attributes are named after the state attributes they mirror.
+func setIdentity(ctx context.Context, state tfsdk.State, identity *tfsdk.ResourceIdentity, diags *diag.Diagnostics) {
+	if identity == nil || state.Raw.IsNull() {
+		return
+	}
+	for name := range identity.Schema.GetAttributes() {
+		var value attr.Value
+		diags.Append(state.GetAttribute(ctx, path.Root(name), &value)...)
+		diags.Append(identity.SetAttribute(ctx, path.Root(name), value)...)
+	}
+}
+
+// importStateFromIdentity copies the identity given in an import block into
+// the state. It reports whether the resource is imported by identity rather
+// than by ID.
+func importStateFromIdentity(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) bool {
+	if req.ID != "" || req.Identity == nil {
+		return false
+	}
+	for name := range req.Identity.Schema.GetAttributes() {
+		var value attr.Value
+		resp.Diagnostics.Append(req.Identity.GetAttribute(ctx, path.Root(name), &value)...)
+		resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root(name), value)...)
+	}
+	return true
+}
+
+// importStateID returns the ID to import: the import ID, or the "id"
+// attribute of the identity given in an import block.
+func importStateID(ctx context.Context, req resource.ImportStateRequest, diags *diag.Diagnostics) string {
+	if req.ID != "" || req.Identity == nil {
+		return req.ID
+	}
+	var id string
+	diags.Append(req.Identity.GetAttribute(ctx, path.Root("id"), &id)...)
+	return id
+}
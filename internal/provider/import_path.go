@@ -0,0 +1,206 @@
+package provider
+
+import (
+	"fmt"
+	"slices"
+	"strings"
+
+	"github.com/ahmedali6/terraform-provider-dokploy/internal/client"
+)
+
+// Import IDs may be name paths instead of raw IDs, for example
+// "project/environment/app_name". Dokploy IDs never contain a slash, so any
+// ID without one is used as is.
+func isImportPath(id string) bool {
+	return strings.Contains(id, "/")
+}
+
+// splitImportPath splits a name path into exactly n non-empty parts.
+func splitImportPath(id string, n int, format string) ([]string, error) {
+	parts := strings.Split(id, "/")
+	if len(parts) != n || slices.Contains(parts, "") {
+		return nil, fmt.Errorf("expected import ID in the format '%s', got: %s", format, id)
+	}
+	return parts, nil
+}
+
+// resolveEnvironmentPath finds an environment by project and environment
+// name. IDs are accepted in place of names.
+func resolveEnvironmentPath(c *client.DokployClient, projectName, environmentName string) (*client.Environment, error) {
+	projects, err := c.ListProjects()
+	if err != nil {
+		return nil, fmt.Errorf("unable to list projects: %w", err)
+	}
+
+	var project *client.Project
+	for i := range projects {
+		if projects[i].ID == projectName || projects[i].Name == projectName {
+			if project != nil {
+				return nil, fmt.Errorf("more than one project is named %q; use the project ID instead", projectName)
+			}
+			project = &projects[i]
+		}
+	}
+	if project == nil {
+		return nil, fmt.Errorf("no project named %q", projectName)
+	}
+
+	var env *client.Environment
+	for i := range project.Environments {
+		if project.Environments[i].ID == environmentName || project.Environments[i].Name == environmentName {
+			if env != nil {
+				return nil, fmt.Errorf("more than one environment of project %q is named %q; use the environment ID instead", projectName, environmentName)
+			}
+			env = &project.Environments[i]
+		}
+	}
+	if env == nil {
+		return nil, fmt.Errorf("project %q has no environment named %q", projectName, environmentName)
+	}
+	env.ProjectID = project.ID
+	return env, nil
+}
+
+// findEnvironmentProject returns the ID of the project an environment
+// belongs to.
+func findEnvironmentProject(c *client.DokployClient, environmentID string) (string, error) {
+	projects, err := c.ListProjects()
+	if err != nil {
+		return "", fmt.Errorf("unable to list projects: %w", err)
+	}
+	for _, project := range projects {
+		for _, env := range project.Environments {
+			if env.ID == environmentID {
+				return project.ID, nil
+			}
+		}
+	}
+	return "", fmt.Errorf("no environment with ID %q", environmentID)
+}
+
+// importedService is a service found by resolveServicePath.
+type importedService struct {
+	Type string // application, compose, postgres, mysql, mariadb, mongo or redis
+	ID   string
+}
+
+// resolveServicePath resolves a "project/environment/name" path to a service
+// of one of the given types. The name is matched against the app name and the
+// display name; IDs are accepted as well.
+func resolveServicePath(c *client.DokployClient, project, environment, name string, serviceTypes ...string) (importedService, error) {
+	env, err := resolveEnvironmentPath(c, project, environment)
+	if err != nil {
+		return importedService{}, err
+	}
+	env, err = c.GetEnvironment(env.ID)
+	if err != nil {
+		return importedService{}, fmt.Errorf("unable to read environment %q: %w", environment, err)
+	}
+
+	var byAppName, byName []importedService
+	match := func(serviceType, id, appName, displayName string) {
+		if !slices.Contains(serviceTypes, serviceType) {
+			return
+		}
+		switch name {
+		case id, appName:
+			byAppName = append(byAppName, importedService{Type: serviceType, ID: id})
+		case displayName:
+			byName = append(byName, importedService{Type: serviceType, ID: id})
+		}
+	}
+	for _, app := range env.Applications {
+		match("application", app.ID, app.AppName, app.Name)
+	}
+	for _, comp := range env.Compose {
+		match("compose", comp.ID, comp.AppName, comp.Name)
+	}
+	for dbType, dbs := range map[string][]client.Database{
+		"postgres": env.Postgres,
+		"mysql":    env.Mysql,
+		"mariadb":  env.Mariadb,
+		"mongo":    env.Mongo,
+		"redis":    env.Redis,
+	} {
+		for _, db := range dbs {
+			match(dbType, databaseTypedID(db), db.AppName, db.Name)
+		}
+	}
+
+	matches := byAppName
+	if len(matches) == 0 {
+		matches = byName
+	}
+	switch len(matches) {
+	case 0:
+		return importedService{}, fmt.Errorf("environment %q of project %q has no %s named %q", environment, project, strings.Join(serviceTypes, " or "), name)
+	case 1:
+		return matches[0], nil
+	default:
+		return importedService{}, fmt.Errorf("more than one service in environment %q is named %q; use its app name or ID instead", environment, name)
+	}
+}
+
+// databaseTypedID returns the ID of a database listed in an environment,
+// which is reported under a key specific to its type.
+func databaseTypedID(db client.Database) string {
+	for _, id := range []string{db.PostgresID, db.MysqlID, db.MariadbID, db.MongoID, db.RedisID, db.ID} {
+		if id != "" {
+			return id
+		}
+	}
+	return ""
+}
+
+// resolveServiceImportID resolves the import ID of a single-type service
+// resource, accepting either a raw ID or "project/environment/app_name".
+func resolveServiceImportID(c *client.DokployClient, importID, serviceType string) (string, error) {
+	if !isImportPath(importID) {
+		return importID, nil
+	}
+	parts, err := splitImportPath(importID, 3, "project/environment/app_name")
+	if err != nil {
+		return "", err
+	}
+	service, err := resolveServicePath(c, parts[0], parts[1], parts[2], serviceType)
+	if err != nil {
+		return "", err
+	}
+	return service.ID, nil
+}
+
+// resolveDomainPath resolves a "project/environment/app/host/path" import ID.
+// The path may be omitted for domains routed at "/". It returns the domain and
+// the application or compose stack it belongs to.
+func resolveDomainPath(c *client.DokployClient, importID string) (importedService, *client.Domain, error) {
+	const format = "project/environment/app/host/path"
+	parts := strings.SplitN(importID, "/", 5)
+	if len(parts) < 4 || slices.Contains(parts[:4], "") {
+		return importedService{}, nil, fmt.Errorf("expected import ID in the format '%s', got: %s", format, importID)
+	}
+	routePath := "/"
+	if len(parts) == 5 {
+		routePath = "/" + parts[4]
+	}
+
+	service, err := resolveServicePath(c, parts[0], parts[1], parts[2], "application", "compose")
+	if err != nil {
+		return importedService{}, nil, err
+	}
+	var domains []client.Domain
+	if service.Type == "application" {
+		domains, err = c.GetDomainsByApplication(service.ID)
+	} else {
+		domains, err = c.GetDomainsByCompose(service.ID)
+	}
+	if err != nil {
+		return importedService{}, nil, fmt.Errorf("unable to list domains of %q: %w", parts[2], err)
+	}
+
+	for i := range domains {
+		if domains[i].Host == parts[3] && normalizeRoutePath(domains[i].Path) == routePath {
+			return service, &domains[i], nil
+		}
+	}
+	return importedService{}, nil, fmt.Errorf("%s %q has no domain %s%s", service.Type, parts[2], parts[3], routePath)
+}
@@ -0,0 +1,167 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/ahmedali6/terraform-provider-dokploy/internal/client"
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/list"
+	"github.com/hashicorp/terraform-plugin-framework/list/schema"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/tfsdk"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-go/tftypes"
+)
+
+var _ list.ListResourceWithConfigure = &listResource{}
+
+// listResource lists the existing objects of a managed resource for
+// terraform query. Each object is found with the state attributes an import
+// would set; when the full resource is requested, the managed resource's Read
+// fills in the rest, exactly as after an import.
+type listResource struct {
+	client *client.DokployClient
+
+	typeName    string
+	description string
+	filters     []listFilter
+	newResource func() resource.Resource
+	list        func(c *client.DokployClient, filter map[string]string) ([]listItem, error)
+}
+
+// listFilter is an optional string argument of a list block.
+type listFilter struct {
+	Name        string
+	Description string
+}
+
+// listItem is an object found by a list resource.
+type listItem struct {
+	// DisplayName is shown by terraform query; where possible it is also an
+	// import ID of the object.
+	DisplayName string
+	Attributes  map[string]attr.Value
+}
+
+func (r *listResource) Metadata(_ context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + r.typeName
+}
+
+func (r *listResource) ListResourceConfigSchema(_ context.Context, _ list.ListResourceSchemaRequest, resp *list.ListResourceSchemaResponse) {
+	attributes := map[string]schema.Attribute{}
+	for _, f := range r.filters {
+		attributes[f.Name] = schema.StringAttribute{
+			Optional:    true,
+			Description: f.Description,
+		}
+	}
+	resp.Schema = schema.Schema{
+		Description: r.description,
+		Attributes:  attributes,
+	}
+}
+
+func (r *listResource) Configure(_ context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+	c, ok := req.ProviderData.(*client.DokployClient)
+	if !ok {
+		resp.Diagnostics.AddError("Unexpected List Resource Configure Type", fmt.Sprintf("Expected *client.DokployClient, got: %T", req.ProviderData))
+		return
+	}
+	r.client = c
+}
+
+func (r *listResource) List(ctx context.Context, req list.ListRequest, stream *list.ListResultsStream) {
+	var diags diag.Diagnostics
+	filter := map[string]string{}
+	for _, f := range r.filters {
+		var value types.String
+		diags.Append(req.Config.GetAttribute(ctx, path.Root(f.Name), &value)...)
+		if value.ValueString() != "" {
+			filter[f.Name] = value.ValueString()
+		}
+	}
+	if diags.HasError() {
+		stream.Results = list.ListResultsStreamDiagnostics(diags)
+		return
+	}
+
+	items, err := r.list(r.client, filter)
+	if err != nil {
+		diags.AddError("Unable to List Resources", err.Error())
+		stream.Results = list.ListResultsStreamDiagnostics(diags)
+		return
+	}
+
+	stream.Results = func(push func(list.ListResult) bool) {
+		var count int64
+		for _, item := range items {
+			if req.Limit > 0 && count >= req.Limit {
+				return
+			}
+			result, found := r.result(ctx, req, item)
+			if !found {
+				continue
+			}
+			count++
+			if !push(result) {
+				return
+			}
+		}
+	}
+}
+
+// result builds the list result of an item. It reports false for objects
+// that were deleted since they were listed.
+func (r *listResource) result(ctx context.Context, req list.ListRequest, item listItem) (list.ListResult, bool) {
+	result := req.NewListResult(ctx)
+	result.DisplayName = item.DisplayName
+
+	state := tfsdk.State{
+		Schema: req.ResourceSchema,
+		Raw:    tftypes.NewValue(req.ResourceSchema.Type().TerraformType(ctx), nil),
+	}
+	for name, value := range item.Attributes {
+		result.Diagnostics.Append(state.SetAttribute(ctx, path.Root(name), value)...)
+	}
+	if result.Diagnostics.HasError() {
+		return result, true
+	}
+
+	if req.IncludeResource {
+		state = r.read(ctx, state, &result.Diagnostics)
+		if result.Diagnostics.HasError() {
+			return result, true
+		}
+		if state.Raw.IsNull() {
+			return result, false
+		}
+		result.Resource = &tfsdk.Resource{Schema: state.Schema, Raw: state.Raw}
+	}
+
+	setIdentity(ctx, state, result.Identity, &result.Diagnostics)
+	return result, true
+}
+
+// read completes the state of a listed object with the managed resource's
+// Read.
+func (r *listResource) read(ctx context.Context, state tfsdk.State, diags *diag.Diagnostics) tfsdk.State {
+	res := r.newResource()
+	if configurable, ok := res.(resource.ResourceWithConfigure); ok {
+		var configureResp resource.ConfigureResponse
+		configurable.Configure(ctx, resource.ConfigureRequest{ProviderData: r.client}, &configureResp)
+		diags.Append(configureResp.Diagnostics...)
+	}
+
+	readResp := resource.ReadResponse{
+		State: tfsdk.State{Schema: state.Schema, Raw: state.Raw.Copy()},
+	}
+	res.Read(ctx, resource.ReadRequest{State: state}, &readResp)
+	diags.Append(readResp.Diagnostics...)
+	return readResp.State
+}
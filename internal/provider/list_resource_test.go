@@ -0,0 +1,97 @@
+package provider
+
+import (
+	"fmt"
+	"os"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/querycheck"
+	"github.com/hashicorp/terraform-plugin-testing/tfversion"
+)
+
+func TestAccListResources(t *testing.T) {
+	host := os.Getenv("DOKPLOY_HOST")
+	apiKey := os.Getenv("DOKPLOY_API_KEY")
+
+	if host == "" || apiKey == "" {
+		t.Skip("DOKPLOY_HOST and DOKPLOY_API_KEY must be set for acceptance tests")
+	}
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		TerraformVersionChecks: []tfversion.TerraformVersionCheck{
+			tfversion.SkipBelow(tfversion.Version1_14_0),
+		},
+		Steps: []resource.TestStep{
+			{
+				Config: testAccListResourcesConfig(),
+			},
+			// Query the projects, applications and ports
+			{
+				Query:  true,
+				Config: testAccListResourcesQueryConfig(),
+				QueryResultChecks: []querycheck.QueryResultCheck{
+					querycheck.ExpectLengthAtLeast("dokploy_project.test", 1),
+					querycheck.ExpectLengthAtLeast("dokploy_application.test", 1),
+					querycheck.ExpectLengthAtLeast("dokploy_port.test", 1),
+				},
+			},
+		},
+	})
+}
+
+func testAccListResourcesConfig() string {
+	return fmt.Sprintf(`
+provider "dokploy" {
+  host    = "%s"
+  api_key = "%s"
+}
+
+resource "dokploy_project" "test" {
+  name        = "test-list-project"
+  description = "Test project for list resources"
+}
+
+resource "dokploy_environment" "test" {
+  project_id = dokploy_project.test.id
+  name       = "test-list-env"
+}
+
+resource "dokploy_application" "test" {
+  environment_id = dokploy_environment.test.id
+  name           = "test-list-app"
+  build_type     = "nixpacks"
+  source_type    = "docker"
+  docker_image   = "nginx:latest"
+}
+
+resource "dokploy_port" "test" {
+  application_id = dokploy_application.test.id
+  published_port = 8089
+  target_port    = 80
+}
+`, os.Getenv("DOKPLOY_HOST"), os.Getenv("DOKPLOY_API_KEY"))
+}
+
+func testAccListResourcesQueryConfig() string {
+	return fmt.Sprintf(`
+provider "dokploy" {
+  host    = "%s"
+  api_key = "%s"
+}
+
+list "dokploy_project" "test" {
+  provider = dokploy
+}
+
+list "dokploy_application" "test" {
+  provider = dokploy
+}
+
+list "dokploy_port" "test" {
+  provider = dokploy
+}
+`, os.Getenv("DOKPLOY_HOST"), os.Getenv("DOKPLOY_API_KEY"))
+}
@@ -0,0 +1,504 @@
+package provider
+
+import (
+	"fmt"
+	"slices"
+
+	"github.com/ahmedali6/terraform-provider-dokploy/internal/client"
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/list"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+var (
+	projectListFilter     = listFilter{Name: "project_id", Description: "Only list objects in this project."}
+	environmentListFilter = listFilter{Name: "environment_id", Description: "Only list objects in this environment."}
+	serverListFilter      = listFilter{Name: "server_id", Description: "Only list objects on this server."}
+	applicationListFilter = listFilter{Name: "application_id", Description: "Only list objects of this application."}
+)
+
+// listedService is an application, compose stack or database found in the
+// project tree.
+type listedService struct {
+	Type     string
+	ID       string
+	ServerID string
+	// Path is the "project/environment/app_name" import ID of the service.
+	Path string
+}
+
+// listServices lists the services of the given types in all projects,
+// keeping those that match the project, environment and server filters.
+func listServices(c *client.DokployClient, filter map[string]string, serviceTypes ...string) ([]listedService, error) {
+	projects, err := c.ListProjects()
+	if err != nil {
+		return nil, err
+	}
+
+	var services []listedService
+	for _, project := range projects {
+		if id, ok := filter["project_id"]; ok && id != project.ID {
+			continue
+		}
+		for _, env := range project.Environments {
+			if id, ok := filter["environment_id"]; ok && id != env.ID {
+				continue
+			}
+			add := func(serviceType, id, appName, serverID string) {
+				if !slices.Contains(serviceTypes, serviceType) {
+					return
+				}
+				if want, ok := filter["server_id"]; ok && want != serverID {
+					return
+				}
+				services = append(services, listedService{
+					Type:     serviceType,
+					ID:       id,
+					ServerID: serverID,
+					Path:     project.Name + "/" + env.Name + "/" + appName,
+				})
+			}
+			for _, app := range env.Applications {
+				add("application", app.ID, app.AppName, app.ServerID)
+			}
+			for _, comp := range env.Compose {
+				add("compose", comp.ID, comp.AppName, comp.ServerID)
+			}
+			for dbType, dbs := range map[string][]client.Database{
+				"postgres": env.Postgres,
+				"mysql":    env.Mysql,
+				"mariadb":  env.Mariadb,
+				"mongo":    env.Mongo,
+				"redis":    env.Redis,
+			} {
+				for _, db := range dbs {
+					add(dbType, databaseTypedID(db), db.AppName, db.ServerID)
+				}
+			}
+		}
+	}
+	slices.SortFunc(services, func(a, b listedService) int {
+		if a.Path < b.Path {
+			return -1
+		}
+		if a.Path > b.Path {
+			return 1
+		}
+		return 0
+	})
+	return services, nil
+}
+
+func idListItem(displayName, id string) listItem {
+	return listItem{
+		DisplayName: displayName,
+		Attributes:  map[string]attr.Value{"id": types.StringValue(id)},
+	}
+}
+
+func NewProjectListResource() list.ListResource {
+	return &listResource{
+		typeName:    "_project",
+		description: "Lists Dokploy projects.",
+		newResource: NewProjectResource,
+		list: func(c *client.DokployClient, _ map[string]string) ([]listItem, error) {
+			projects, err := c.ListProjects()
+			if err != nil {
+				return nil, err
+			}
+			var items []listItem
+			for _, project := range projects {
+				items = append(items, idListItem(project.Name, project.ID))
+			}
+			return items, nil
+		},
+	}
+}
+
+func NewEnvironmentListResource() list.ListResource {
+	return &listResource{
+		typeName:    "_environment",
+		description: "Lists the environments of Dokploy projects.",
+		filters:     []listFilter{projectListFilter},
+		newResource: NewEnvironmentResource,
+		list: func(c *client.DokployClient, filter map[string]string) ([]listItem, error) {
+			projects, err := c.ListProjects()
+			if err != nil {
+				return nil, err
+			}
+			var items []listItem
+			for _, project := range projects {
+				if id, ok := filter["project_id"]; ok && id != project.ID {
+					continue
+				}
+				for _, env := range project.Environments {
+					items = append(items, listItem{
+						DisplayName: project.Name + "/" + env.Name,
+						Attributes: map[string]attr.Value{
+							"id":         types.StringValue(env.ID),
+							"project_id": types.StringValue(project.ID),
+						},
+					})
+				}
+			}
+			return items, nil
+		},
+	}
+}
+
+// newServiceListResource lists the services of one type.
+func newServiceListResource(typeName, description, serviceType string, newResource func() resource.Resource) list.ListResource {
+	return &listResource{
+		typeName:    typeName,
+		description: description,
+		filters:     []listFilter{projectListFilter, environmentListFilter, serverListFilter},
+		newResource: newResource,
+		list: func(c *client.DokployClient, filter map[string]string) ([]listItem, error) {
+			services, err := listServices(c, filter, serviceType)
+			if err != nil {
+				return nil, err
+			}
+			var items []listItem
+			for _, service := range services {
+				items = append(items, idListItem(service.Path, service.ID))
+			}
+			return items, nil
+		},
+	}
+}
+
+func NewApplicationListResource() list.ListResource {
+	return newServiceListResource("_application", "Lists Dokploy applications.", "application", NewApplicationResource)
+}
+
+func NewComposeListResource() list.ListResource {
+	return newServiceListResource("_compose", "Lists Dokploy compose stacks.", "compose", NewComposeResource)
+}
+
+func NewPostgresListResource() list.ListResource {
+	return newServiceListResource("_postgres", "Lists PostgreSQL databases.", "postgres", NewPostgresResource)
+}
+
+func NewMySQLListResource() list.ListResource {
+	return newServiceListResource("_mysql", "Lists MySQL databases.", "mysql", NewMySQLResource)
+}
+
+func NewMariaDBListResource() list.ListResource {
+	return newServiceListResource("_mariadb", "Lists MariaDB databases.", "mariadb", NewMariaDBResource)
+}
+
+func NewMongoDBListResource() list.ListResource {
+	return newServiceListResource("_mongo", "Lists MongoDB databases.", "mongo", NewMongoDBResource)
+}
+
+func NewRedisListResource() list.ListResource {
+	return newServiceListResource("_redis", "Lists Redis databases.", "redis", NewRedisResource)
+}
+
+func NewDatabaseListResource() list.ListResource {
+	return &listResource{
+		typeName:    "_database",
+		description: "Lists databases of every type.",
+		filters:     []listFilter{projectListFilter, environmentListFilter, serverListFilter},
+		newResource: NewDatabaseResource,
+		list: func(c *client.DokployClient, filter map[string]string) ([]listItem, error) {
+			services, err := listServices(c, filter, databaseTypes...)
+			if err != nil {
+				return nil, err
+			}
+			var items []listItem
+			for _, service := range services {
+				items = append(items, listItem{
+					DisplayName: service.Path,
+					Attributes: map[string]attr.Value{
+						"id":   types.StringValue(service.ID),
+						"type": types.StringValue(service.Type),
+					},
+				})
+			}
+			return items, nil
+		},
+	}
+}
+
+func NewDomainListResource() list.ListResource {
+	return &listResource{
+		typeName:    "_domain",
+		description: "Lists the domains of applications and compose stacks.",
+		filters: []listFilter{
+			projectListFilter,
+			environmentListFilter,
+			serverListFilter,
+			applicationListFilter,
+			{Name: "compose_id", Description: "Only list domains of this compose stack."},
+		},
+		newResource: NewDomainResource,
+		list: func(c *client.DokployClient, filter map[string]string) ([]listItem, error) {
+			services, err := listServices(c, filter, "application", "compose")
+			if err != nil {
+				return nil, err
+			}
+			var items []listItem
+			for _, service := range services {
+				if !matchesParentFilter(filter, service) {
+					continue
+				}
+				var domains []client.Domain
+				if service.Type == "application" {
+					domains, err = c.GetDomainsByApplication(service.ID)
+				} else {
+					domains, err = c.GetDomainsByCompose(service.ID)
+				}
+				if err != nil {
+					return nil, fmt.Errorf("unable to list domains of %s: %w", service.Path, err)
+				}
+				for _, domain := range domains {
+					items = append(items, listItem{
+						DisplayName: service.Path + "/" + domain.Host + normalizeRoutePath(domain.Path),
+						Attributes: map[string]attr.Value{
+							"id":                 types.StringValue(domain.ID),
+							service.Type + "_id": types.StringValue(service.ID),
+						},
+					})
+				}
+			}
+			return items, nil
+		},
+	}
+}
+
+// matchesParentFilter reports whether a service matches the application_id
+// and compose_id filters of a list block. Without either, every service
+// matches.
+func matchesParentFilter(filter map[string]string, service listedService) bool {
+	applicationID, byApplication := filter["application_id"]
+	composeID, byCompose := filter["compose_id"]
+	if !byApplication && !byCompose {
+		return true
+	}
+	return (byApplication && service.Type == "application" && service.ID == applicationID) ||
+		(byCompose && service.Type == "compose" && service.ID == composeID)
+}
+
+func NewMountListResource() list.ListResource {
+	return &listResource{
+		typeName:    "_mount",
+		description: "Lists the mounts of applications, compose stacks and databases.",
+		filters: []listFilter{
+			projectListFilter,
+			environmentListFilter,
+			serverListFilter,
+			{Name: "service_id", Description: "Only list mounts of this service."},
+		},
+		newResource: NewMountResource,
+		list: func(c *client.DokployClient, filter map[string]string) ([]listItem, error) {
+			services, err := listServices(c, filter, append([]string{"application", "compose"}, databaseTypes...)...)
+			if err != nil {
+				return nil, err
+			}
+			var items []listItem
+			for _, service := range services {
+				if id, ok := filter["service_id"]; ok && id != service.ID {
+					continue
+				}
+				mounts, err := c.GetMountsByService(service.ID, service.Type)
+				if err != nil {
+					return nil, fmt.Errorf("unable to list mounts of %s: %w", service.Path, err)
+				}
+				for _, mount := range mounts {
+					items = append(items, listItem{
+						DisplayName: service.Path + ":" + mount.MountPath,
+						Attributes: map[string]attr.Value{
+							"id":           types.StringValue(mount.ID),
+							"service_type": types.StringValue(service.Type),
+							"service_id":   types.StringValue(service.ID),
+							"mount_path":   types.StringValue(mount.MountPath),
+						},
+					})
+				}
+			}
+			return items, nil
+		},
+	}
+}
+
+func NewPortListResource() list.ListResource {
+	return &listResource{
+		typeName:    "_port",
+		description: "Lists the published ports of applications.",
+		filters:     []listFilter{projectListFilter, environmentListFilter, serverListFilter, applicationListFilter},
+		newResource: NewPortResource,
+		list: func(c *client.DokployClient, filter map[string]string) ([]listItem, error) {
+			services, err := listServices(c, filter, "application")
+			if err != nil {
+				return nil, err
+			}
+			var items []listItem
+			for _, service := range services {
+				if !matchesParentFilter(filter, service) {
+					continue
+				}
+				ports, err := c.GetPortsByApplication(service.ID)
+				if err != nil {
+					return nil, fmt.Errorf("unable to list ports of %s: %w", service.Path, err)
+				}
+				for _, port := range ports {
+					items = append(items, listItem{
+						DisplayName: fmt.Sprintf("%s:%d/%s", service.Path, port.PublishedPort, port.Protocol),
+						Attributes: map[string]attr.Value{
+							"id":             types.StringValue(port.ID),
+							"application_id": types.StringValue(service.ID),
+							"published_port": types.Int64Value(port.PublishedPort),
+							"protocol":       types.StringValue(port.Protocol),
+						},
+					})
+				}
+			}
+			return items, nil
+		},
+	}
+}
+
+func NewRedirectListResource() list.ListResource {
+	return &listResource{
+		typeName:    "_redirect",
+		description: "Lists the redirects of applications.",
+		filters:     []listFilter{projectListFilter, environmentListFilter, serverListFilter, applicationListFilter},
+		newResource: NewRedirectResource,
+		list: func(c *client.DokployClient, filter map[string]string) ([]listItem, error) {
+			services, err := listServices(c, filter, "application")
+			if err != nil {
+				return nil, err
+			}
+			var items []listItem
+			for _, service := range services {
+				if !matchesParentFilter(filter, service) {
+					continue
+				}
+				redirects, err := c.GetRedirectsByApplication(service.ID)
+				if err != nil {
+					return nil, fmt.Errorf("unable to list redirects of %s: %w", service.Path, err)
+				}
+				for _, redirect := range redirects {
+					items = append(items, listItem{
+						DisplayName: service.Path + " " + redirect.Regex,
+						Attributes: map[string]attr.Value{
+							"id":             types.StringValue(redirect.ID),
+							"application_id": types.StringValue(service.ID),
+						},
+					})
+				}
+			}
+			return items, nil
+		},
+	}
+}
+
+func NewBackupListResource() list.ListResource {
+	return &listResource{
+		typeName:    "_backup",
+		description: "Lists the scheduled backups of databases and compose stacks.",
+		filters:     []listFilter{projectListFilter, environmentListFilter, serverListFilter},
+		newResource: NewBackupResource,
+		list: func(c *client.DokployClient, filter map[string]string) ([]listItem, error) {
+			// Redis has no backups
+			services, err := listServices(c, filter, "compose", "postgres", "mysql", "mariadb", "mongo")
+			if err != nil {
+				return nil, err
+			}
+			var items []listItem
+			for _, service := range services {
+				var backups []client.Backup
+				if service.Type == "compose" {
+					backups, err = c.GetBackupsByComposeID(service.ID)
+				} else {
+					backups, err = c.GetBackupsByDatabaseID(service.ID, service.Type)
+				}
+				if err != nil {
+					return nil, fmt.Errorf("unable to list backups of %s: %w", service.Path, err)
+				}
+				for _, backup := range backups {
+					items = append(items, idListItem(service.Path+" "+backup.Schedule, backup.BackupID))
+				}
+			}
+			return items, nil
+		},
+	}
+}
+
+func NewServerListResource() list.ListResource {
+	return &listResource{
+		typeName:    "_server",
+		description: "Lists remote servers.",
+		newResource: NewServerResource,
+		list: func(c *client.DokployClient, _ map[string]string) ([]listItem, error) {
+			servers, err := c.ListServers()
+			if err != nil {
+				return nil, err
+			}
+			var items []listItem
+			for _, server := range servers {
+				items = append(items, idListItem(server.Name, server.ID))
+			}
+			return items, nil
+		},
+	}
+}
+
+func NewSSHKeyListResource() list.ListResource {
+	return &listResource{
+		typeName:    "_ssh_key",
+		description: "Lists SSH keys.",
+		newResource: NewSSHKeyResource,
+		list: func(c *client.DokployClient, _ map[string]string) ([]listItem, error) {
+			keys, err := c.ListSSHKeys()
+			if err != nil {
+				return nil, err
+			}
+			var items []listItem
+			for _, key := range keys {
+				items = append(items, idListItem(key.Name, key.ID))
+			}
+			return items, nil
+		},
+	}
+}
+
+func NewRegistryListResource() list.ListResource {
+	return &listResource{
+		typeName:    "_registry",
+		description: "Lists Docker registries.",
+		newResource: NewRegistryResource,
+		list: func(c *client.DokployClient, _ map[string]string) ([]listItem, error) {
+			registries, err := c.ListRegistries()
+			if err != nil {
+				return nil, err
+			}
+			var items []listItem
+			for _, registry := range registries {
+				items = append(items, idListItem(registry.RegistryName, registry.ID))
+			}
+			return items, nil
+		},
+	}
+}
+
+func NewDestinationListResource() list.ListResource {
+	return &listResource{
+		typeName:    "_destination",
+		description: "Lists backup destinations.",
+		newResource: NewDestinationResource,
+		list: func(c *client.DokployClient, _ map[string]string) ([]listItem, error) {
+			destinations, err := c.ListDestinations()
+			if err != nil {
+				return nil, err
+			}
+			var items []listItem
+			for _, destination := range destinations {
+				items = append(items, idListItem(destination.Name, destination.DestinationID))
+			}
+			return items, nil
+		},
+	}
+}
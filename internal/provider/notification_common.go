@@ -0,0 +1,109 @@
+package provider
+
+import (
+	"fmt"
+
+	"github.com/ahmedali6/terraform-provider-dokploy/internal/client"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/booldefault"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+// NotificationResourceModel holds the attributes shared by all notification
+// resources. It is embedded in each backend-specific model.
+type NotificationResourceModel struct {
+	ID              types.String `tfsdk:"id"`
+	Name            types.String `tfsdk:"name"`
+	AppDeploy       types.Bool   `tfsdk:"app_deploy"`
+	AppBuildError   types.Bool   `tfsdk:"app_build_error"`
+	DatabaseBackup  types.Bool   `tfsdk:"database_backup"`
+	DockerCleanup   types.Bool   `tfsdk:"docker_cleanup"`
+	ServerThreshold types.Bool   `tfsdk:"server_threshold"`
+	DokployRestart  types.Bool   `tfsdk:"dokploy_restart"`
+	OrganizationID  types.String `tfsdk:"organization_id"`
+	CreatedAt       types.String `tfsdk:"created_at"`
+}
+
+// notificationSchemaAttributes returns the attributes shared by all
+// notification resources.
+func notificationSchemaAttributes() map[string]schema.Attribute {
+	event := func(description string) schema.BoolAttribute {
+		return schema.BoolAttribute{
+			Optional:    true,
+			Computed:    true,
+			Default:     booldefault.StaticBool(false),
+			Description: description + " Default: false.",
+		}
+	}
+
+	return map[string]schema.Attribute{
+		"id": schema.StringAttribute{
+			Computed:    true,
+			Description: "The unique identifier of the notification.",
+			PlanModifiers: []planmodifier.String{
+				stringplanmodifier.UseStateForUnknown(),
+			},
+		},
+		"name": schema.StringAttribute{
+			Required:    true,
+			Description: "The name of the notification.",
+		},
+		"app_deploy":       event("Notify when an application or compose stack is deployed."),
+		"app_build_error":  event("Notify when an application build fails."),
+		"database_backup":  event("Notify when a database backup finishes."),
+		"docker_cleanup":   event("Notify when Docker cleanup runs."),
+		"server_threshold": event("Notify when a server exceeds its CPU or memory threshold."),
+		"dokploy_restart":  event("Notify when Dokploy restarts."),
+		"organization_id": schema.StringAttribute{
+			Computed:    true,
+			Description: "The Dokploy organization ID.",
+			PlanModifiers: []planmodifier.String{
+				stringplanmodifier.UseStateForUnknown(),
+			},
+		},
+		"created_at": schema.StringAttribute{
+			Computed:    true,
+			Description: "The creation timestamp.",
+			PlanModifiers: []planmodifier.String{
+				stringplanmodifier.UseStateForUnknown(),
+			},
+		},
+	}
+}
+
+// payload returns the API fields shared by all notification types.
+func (m *NotificationResourceModel) payload() map[string]interface{} {
+	return map[string]interface{}{
+		"name":            m.Name.ValueString(),
+		"appDeploy":       m.AppDeploy.ValueBool(),
+		"appBuildError":   m.AppBuildError.ValueBool(),
+		"databaseBackup":  m.DatabaseBackup.ValueBool(),
+		"dockerCleanup":   m.DockerCleanup.ValueBool(),
+		"serverThreshold": m.ServerThreshold.ValueBool(),
+		"dokployRestart":  m.DokployRestart.ValueBool(),
+	}
+}
+
+func (m *NotificationResourceModel) mapFromAPI(n *client.Notification) {
+	m.ID = types.StringValue(n.NotificationID)
+	m.Name = types.StringValue(n.Name)
+	m.AppDeploy = types.BoolValue(n.AppDeploy)
+	m.AppBuildError = types.BoolValue(n.AppBuildError)
+	m.DatabaseBackup = types.BoolValue(n.DatabaseBackup)
+	m.DockerCleanup = types.BoolValue(n.DockerCleanup)
+	m.ServerThreshold = types.BoolValue(n.ServerThreshold)
+	m.DokployRestart = types.BoolValue(n.DokployRestart)
+	m.OrganizationID = types.StringValue(n.OrganizationID)
+	m.CreatedAt = types.StringValue(n.CreatedAt)
+}
+
+// checkNotificationType guards against importing a notification into the
+// resource for a different backend.
+func checkNotificationType(n *client.Notification, notificationType string) error {
+	if n.NotificationType != notificationType {
+		return fmt.Errorf("notification %s is of type %q, expected %q", n.NotificationID, n.NotificationType, notificationType)
+	}
+	return nil
+}
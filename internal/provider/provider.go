@@ -4,8 +4,11 @@ import (
 	"context"
 
 	"github.com/ahmedali6/terraform-provider-dokploy/internal/client"
+	"github.com/hashicorp/terraform-plugin-framework/action"
 	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/ephemeral"
 	"github.com/hashicorp/terraform-plugin-framework/function"
+	"github.com/hashicorp/terraform-plugin-framework/list"
 	"github.com/hashicorp/terraform-plugin-framework/provider"
 	"github.com/hashicorp/terraform-plugin-framework/provider/schema"
 	"github.com/hashicorp/terraform-plugin-framework/resource"
@@ -14,6 +17,9 @@ import (
 
 var _ provider.Provider = &DokployProvider{}
 var _ provider.ProviderWithFunctions = &DokployProvider{}
+var _ provider.ProviderWithEphemeralResources = &DokployProvider{}
+var _ provider.ProviderWithActions = &DokployProvider{}
+var _ provider.ProviderWithListResources = &DokployProvider{}
 
 type DokployProvider struct {
 	version string
@@ -77,6 +83,9 @@ func (p *DokployProvider) Configure(ctx context.Context, req provider.ConfigureR
 	// Make client available to resources
 	resp.ResourceData = c
 	resp.DataSourceData = c
+	resp.EphemeralResourceData = c
+	resp.ActionData = c
+	resp.ListResourceData = c
 }
 
 func (p *DokployProvider) Resources(_ context.Context) []func() resource.Resource {
@@ -103,12 +112,27 @@ func (p *DokployProvider) Resources(_ context.Context) []func() resource.Resourc
 		NewGitlabProviderResource,
 		NewBitbucketProviderResource,
 		NewGiteaProviderResource,
+		NewGithubProviderResource,
 		NewOrganizationResource,
 		NewVolumeBackupResource,
 		NewApiKeyResource,
 		NewUserPermissionsResource,
 		NewAIResource,
 		NewCertificateResource,
+		NewDatabaseResource,
+		NewScheduleResource,
+		NewSlackNotificationResource,
+		NewDiscordNotificationResource,
+		NewTelegramNotificationResource,
+		NewEmailNotificationResource,
+		NewGotifyNotificationResource,
+		NewNtfyNotificationResource,
+		NewWebhookNotificationResource,
+		NewApplicationSecurityResource,
+		NewWebServerSettingsResource,
+		NewServerSettingsResource,
+		NewTraefikFileResource,
+		NewComposeTemplateResource,
 	}
 }
 
@@ -130,13 +154,59 @@ func (p *DokployProvider) DataSources(_ context.Context) []func() datasource.Dat
 		NewApplicationsDataSource,
 		NewCertificateDataSource,
 		NewCertificatesDataSource,
+		NewDomainCertificateDataSource,
 		NewComposeDataSource,
 		NewComposesDataSource,
+		NewComposeTemplatesDataSource,
+		NewComposeConvertedDataSource,
+		NewComposeServicesDataSource,
 		NewDeploymentsDataSource,
 		NewDestinationDataSource,
 		NewDestinationsDataSource,
 		NewDockerContainerDataSource,
 		NewDockerContainersDataSource,
+		NewScheduleRunsDataSource,
+		NewProjectDataSource,
+		NewProjectsDataSource,
+		NewEnvironmentDataSource,
+		NewEnvironmentsDataSource,
+	}
+}
+
+func (p *DokployProvider) EphemeralResources(_ context.Context) []func() ephemeral.EphemeralResource {
+	return []func() ephemeral.EphemeralResource{
+		NewApiKeyEphemeralResource,
+		NewDatabaseCredentialsEphemeralResource,
+	}
+}
+
+func (p *DokployProvider) ListResources(_ context.Context) []func() list.ListResource {
+	return []func() list.ListResource{
+		NewProjectListResource,
+		NewEnvironmentListResource,
+		NewApplicationListResource,
+		NewComposeListResource,
+		NewDatabaseListResource,
+		NewPostgresListResource,
+		NewMySQLListResource,
+		NewMariaDBListResource,
+		NewMongoDBListResource,
+		NewRedisListResource,
+		NewDomainListResource,
+		NewMountListResource,
+		NewPortListResource,
+		NewRedirectListResource,
+		NewRegistryListResource,
+		NewDestinationListResource,
+		NewBackupListResource,
+		NewServerListResource,
+		NewSSHKeyListResource,
+	}
+}
+
+func (p *DokployProvider) Actions(_ context.Context) []func() action.Action {
+	return []func() action.Action{
+		NewTestNotificationAction,
 	}
 }
 
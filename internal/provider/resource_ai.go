@@ -17,6 +17,7 @@ import (
 
 var _ resource.Resource = &AIResource{}
 var _ resource.ResourceWithImportState = &AIResource{}
+var _ resource.ResourceWithIdentity = &AIResource{}
 
 func NewAIResource() resource.Resource {
 	return &AIResource{}
@@ -93,6 +94,10 @@ func (r *AIResource) Schema(_ context.Context, _ resource.SchemaRequest, resp *r
 	}
 }
 
+func (r *AIResource) IdentitySchema(_ context.Context, _ resource.IdentitySchemaRequest, resp *resource.IdentitySchemaResponse) {
+	resp.IdentitySchema = idIdentitySchema()
+}
+
 func (r *AIResource) Configure(_ context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
 	if req.ProviderData == nil {
 		return
@@ -136,6 +141,7 @@ func (r *AIResource) Create(ctx context.Context, req resource.CreateRequest, res
 
 	diags = resp.State.Set(ctx, plan)
 	resp.Diagnostics.Append(diags...)
+	setIdentity(ctx, resp.State, resp.Identity, &resp.Diagnostics)
 }
 
 func (r *AIResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
@@ -166,6 +172,7 @@ func (r *AIResource) Read(ctx context.Context, req resource.ReadRequest, resp *r
 
 	diags = resp.State.Set(ctx, state)
 	resp.Diagnostics.Append(diags...)
+	setIdentity(ctx, resp.State, resp.Identity, &resp.Diagnostics)
 }
 
 func (r *AIResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
@@ -216,6 +223,7 @@ func (r *AIResource) Update(ctx context.Context, req resource.UpdateRequest, res
 
 	diags = resp.State.Set(ctx, plan)
 	resp.Diagnostics.Append(diags...)
+	setIdentity(ctx, resp.State, resp.Identity, &resp.Diagnostics)
 }
 
 func (r *AIResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
@@ -237,7 +245,7 @@ func (r *AIResource) Delete(ctx context.Context, req resource.DeleteRequest, res
 }
 
 func (r *AIResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
-	resource.ImportStatePassthroughID(ctx, path.Root("id"), req, resp)
+	resource.ImportStatePassthroughWithIdentity(ctx, path.Root("id"), path.Root("id"), req, resp)
 
 	// Warn that api_key won't be imported properly
 	resp.Diagnostics.AddWarning(
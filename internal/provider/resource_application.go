@@ -7,6 +7,7 @@ import (
 	"strings"
 
 	"github.com/ahmedali6/terraform-provider-dokploy/internal/client"
+	"github.com/hashicorp/terraform-plugin-framework-validators/int64validator"
 	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
 	"github.com/hashicorp/terraform-plugin-framework/path"
 	"github.com/hashicorp/terraform-plugin-framework/resource"
@@ -18,10 +19,14 @@ import (
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
 	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
 	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-go/tfprotov6"
 )
 
 var _ resource.Resource = &ApplicationResource{}
 var _ resource.ResourceWithImportState = &ApplicationResource{}
+var _ resource.ResourceWithIdentity = &ApplicationResource{}
+var _ resource.ResourceWithUpgradeState = &ApplicationResource{}
+var _ resource.ResourceWithValidateConfig = &ApplicationResource{}
 
 func NewApplicationResource() resource.Resource {
 	return &ApplicationResource{}
@@ -32,6 +37,7 @@ type ApplicationResource struct {
 }
 
 type ApplicationResourceModel struct {
+	DeletionOptionsModel
 	ID            types.String `tfsdk:"id"`
 	EnvironmentID types.String `tfsdk:"environment_id"`
 	Name          types.String `tfsdk:"name"`
@@ -87,11 +93,13 @@ type ApplicationResourceModel struct {
 	GiteaBuildPath  types.String `tfsdk:"gitea_build_path"`
 
 	// Docker provider settings (for source_type = "docker")
-	DockerImage types.String `tfsdk:"docker_image"`
-	Username    types.String `tfsdk:"username"`
-	Password    types.String `tfsdk:"password"`
-	RegistryUrl types.String `tfsdk:"registry_url"`
-	RegistryId  types.String `tfsdk:"registry_id"`
+	DockerImage       types.String `tfsdk:"docker_image"`
+	Username          types.String `tfsdk:"username"`
+	Password          types.String `tfsdk:"password"`
+	PasswordWO        types.String `tfsdk:"password_wo"`
+	PasswordWOVersion types.Int64  `tfsdk:"password_wo_version"`
+	RegistryUrl       types.String `tfsdk:"registry_url"`
+	RegistryId        types.String `tfsdk:"registry_id"`
 
 	// Build type settings
 	BuildType         types.String `tfsdk:"build_type"`
@@ -155,20 +163,20 @@ type ApplicationResourceModel struct {
 	// Application status (computed)
 	ApplicationStatus types.String `tfsdk:"application_status"`
 
-	// Docker Swarm configuration (stored as JSON strings)
-	HealthCheckSwarm     types.String `tfsdk:"health_check_swarm"`
-	RestartPolicySwarm   types.String `tfsdk:"restart_policy_swarm"`
-	PlacementSwarm       types.String `tfsdk:"placement_swarm"`
-	UpdateConfigSwarm    types.String `tfsdk:"update_config_swarm"`
-	RollbackConfigSwarm  types.String `tfsdk:"rollback_config_swarm"`
-	ModeSwarm            types.String `tfsdk:"mode_swarm"`
-	LabelsSwarm          types.String `tfsdk:"labels_swarm"`
-	NetworkSwarm         types.String `tfsdk:"network_swarm"`
-	StopGracePeriodSwarm types.Int64  `tfsdk:"stop_grace_period_swarm"`
-	EndpointSpecSwarm    types.String `tfsdk:"endpoint_spec_swarm"`
+	// Docker Swarm configuration
+	HealthCheckSwarm     *SwarmHealthCheckModel   `tfsdk:"health_check_swarm"`
+	RestartPolicySwarm   *SwarmRestartPolicyModel `tfsdk:"restart_policy_swarm"`
+	PlacementSwarm       *SwarmPlacementModel     `tfsdk:"placement_swarm"`
+	UpdateConfigSwarm    *SwarmUpdateConfigModel  `tfsdk:"update_config_swarm"`
+	RollbackConfigSwarm  *SwarmUpdateConfigModel  `tfsdk:"rollback_config_swarm"`
+	ModeSwarm            *SwarmModeModel          `tfsdk:"mode_swarm"`
+	LabelsSwarm          map[string]types.String  `tfsdk:"labels_swarm"`
+	NetworkSwarm         []SwarmNetworkModel      `tfsdk:"network_swarm"`
+	StopGracePeriodSwarm types.Int64              `tfsdk:"stop_grace_period_swarm"`
+	EndpointSpecSwarm    *SwarmEndpointSpecModel  `tfsdk:"endpoint_spec_swarm"`
 
 	// Traefik configuration
-	TraefikConfig types.String `tfsdk:"traefik_config"`
+	TraefikConfig YAMLValue `tfsdk:"traefik_config"`
 }
 
 func (r *ApplicationResource) Metadata(_ context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
@@ -177,6 +185,7 @@ func (r *ApplicationResource) Metadata(_ context.Context, req resource.MetadataR
 
 func (r *ApplicationResource) Schema(_ context.Context, _ resource.SchemaRequest, resp *resource.SchemaResponse) {
 	resp.Schema = schema.Schema{
+		Version:     1,
 		Description: "Manages a Dokploy application. Supports multiple source types including GitHub, GitLab, Bitbucket, Gitea, custom Git repositories, and Docker images.",
 		Attributes: map[string]schema.Attribute{
 			// Core attributes
@@ -400,6 +409,22 @@ func (r *ApplicationResource) Schema(_ context.Context, _ resource.SchemaRequest
 				Optional:    true,
 				Sensitive:   true,
 				Description: "Password for Docker registry authentication.",
+				Validators: []validator.String{
+					stringvalidator.ConflictsWith(path.MatchRoot("password_wo")),
+				},
+			},
+			"password_wo": schema.StringAttribute{
+				Optional:    true,
+				Sensitive:   true,
+				WriteOnly:   true,
+				Description: "Write-only password for Docker registry authentication. It is sent to Dokploy but never stored in plan or state. Requires Terraform 1.11 or later.",
+			},
+			"password_wo_version": schema.Int64Attribute{
+				Optional:    true,
+				Description: "Version of password_wo. Change this value to send an updated write-only value to Dokploy.",
+				Validators: []validator.Int64{
+					int64validator.AlsoRequires(path.MatchRoot("password_wo")),
+				},
 			},
 			"registry_url": schema.StringAttribute{
 				Optional:    true,
@@ -637,55 +662,81 @@ func (r *ApplicationResource) Schema(_ context.Context, _ resource.SchemaRequest
 				Description: "Current status of the application: idle, running, done, error.",
 			},
 
-			// Docker Swarm configuration
-			"health_check_swarm": schema.StringAttribute{
-				Optional:    true,
-				Description: "Health check configuration for Docker Swarm mode (JSON format).",
-			},
-			"restart_policy_swarm": schema.StringAttribute{
-				Optional:    true,
-				Description: "Restart policy configuration for Docker Swarm mode (JSON format).",
-			},
-			"placement_swarm": schema.StringAttribute{
-				Optional:    true,
-				Description: "Placement constraints for Docker Swarm mode (JSON format).",
-			},
-			"update_config_swarm": schema.StringAttribute{
-				Optional:    true,
-				Description: "Update configuration for Docker Swarm mode (JSON format).",
-			},
-			"rollback_config_swarm": schema.StringAttribute{
-				Optional:    true,
-				Description: "Rollback configuration for Docker Swarm mode (JSON format).",
-			},
-			"mode_swarm": schema.StringAttribute{
-				Optional:    true,
-				Description: "Service mode for Docker Swarm: replicated or global (JSON format).",
-			},
-			"labels_swarm": schema.StringAttribute{
-				Optional:    true,
-				Description: "Labels for Docker Swarm service (JSON format).",
-			},
-			"network_swarm": schema.StringAttribute{
-				Optional:    true,
-				Description: "Network configuration for Docker Swarm mode (JSON array format).",
-			},
-			"stop_grace_period_swarm": schema.Int64Attribute{
-				Optional:    true,
-				Description: "Stop grace period in nanoseconds for Docker Swarm mode.",
-			},
-			"endpoint_spec_swarm": schema.StringAttribute{
-				Optional:    true,
-				Description: "Endpoint specification for Docker Swarm mode (JSON format).",
-			},
-
 			// Traefik configuration
 			"traefik_config": schema.StringAttribute{
+				CustomType:  YAMLType{},
 				Optional:    true,
-				Description: "Custom Traefik configuration for the application. This allows you to define custom routing rules, middleware, and other Traefik-specific settings.",
+				Description: "Custom Traefik configuration for the application. This allows you to define custom routing rules, middleware, and other Traefik-specific settings. Formatting, comments and key order are ignored when comparing with the stored configuration.",
 			},
 		},
 	}
+	for name, attr := range swarmSchemaAttributes() {
+		resp.Schema.Attributes[name] = attr
+	}
+	for name, attr := range deletionOptionsSchemaAttributes("the Docker volumes of the application's named volume mounts") {
+		resp.Schema.Attributes[name] = attr
+	}
+}
+
+func (r *ApplicationResource) IdentitySchema(_ context.Context, _ resource.IdentitySchemaRequest, resp *resource.IdentitySchemaResponse) {
+	resp.IdentitySchema = idIdentitySchema()
+}
+
+// swarmJSONAttributes are the Docker Swarm attributes that were JSON strings
+// in schema version 0.
+var swarmJSONAttributes = []string{
+	"health_check_swarm",
+	"restart_policy_swarm",
+	"placement_swarm",
+	"update_config_swarm",
+	"rollback_config_swarm",
+	"mode_swarm",
+	"labels_swarm",
+	"network_swarm",
+	"endpoint_spec_swarm",
+}
+
+func (r *ApplicationResource) UpgradeState(_ context.Context) map[int64]resource.StateUpgrader {
+	return map[int64]resource.StateUpgrader{
+		// Version 0 stored the Docker Swarm settings as JSON strings. They are
+		// dropped from state and refreshed from the API on the next read.
+		0: {
+			StateUpgrader: func(ctx context.Context, req resource.UpgradeStateRequest, resp *resource.UpgradeStateResponse) {
+				var rawState map[string]json.RawMessage
+				if err := json.Unmarshal(req.RawState.JSON, &rawState); err != nil {
+					resp.Diagnostics.AddError("Unable to Upgrade Application State", err.Error())
+					return
+				}
+				for _, name := range swarmJSONAttributes {
+					delete(rawState, name)
+				}
+				upgraded, err := json.Marshal(rawState)
+				if err != nil {
+					resp.Diagnostics.AddError("Unable to Upgrade Application State", err.Error())
+					return
+				}
+				resp.DynamicValue = &tfprotov6.DynamicValue{JSON: upgraded}
+			},
+		},
+	}
+}
+
+func (r *ApplicationResource) ValidateConfig(ctx context.Context, req resource.ValidateConfigRequest, resp *resource.ValidateConfigResponse) {
+	var mode types.String
+	var replicas types.Int64
+	resp.Diagnostics.Append(req.Config.GetAttribute(ctx, path.Root("mode_swarm").AtName("mode"), &mode)...)
+	resp.Diagnostics.Append(req.Config.GetAttribute(ctx, path.Root("mode_swarm").AtName("replicas"), &replicas)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	if mode.ValueString() == "global" && !replicas.IsNull() {
+		resp.Diagnostics.AddAttributeError(
+			path.Root("mode_swarm").AtName("replicas"),
+			"Invalid Attribute Combination",
+			"replicas is not supported when mode is \"global\".",
+		)
+	}
 }
 
 func (r *ApplicationResource) Configure(_ context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
@@ -748,7 +799,11 @@ func (r *ApplicationResource) Create(ctx context.Context, req resource.CreateReq
 	}
 
 	// 4. Configure source provider based on source_type
-	if err := r.saveSourceProvider(createdApp.ID, &plan); err != nil {
+	password := writeOnlyValue(ctx, req.Config, path.Root("password_wo"), plan.Password, &resp.Diagnostics)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	if err := r.saveSourceProvider(createdApp.ID, &plan, password); err != nil {
 		resp.Diagnostics.AddError("Error saving source provider", err.Error())
 		return
 	}
@@ -783,7 +838,7 @@ func (r *ApplicationResource) Create(ctx context.Context, req resource.CreateReq
 		if err != nil {
 			resp.Diagnostics.AddWarning("Error reading Traefik config", err.Error())
 		} else if traefikConfig != "" {
-			plan.TraefikConfig = types.StringValue(traefikConfig)
+			plan.TraefikConfig = NewYAMLValue(traefikConfig)
 		}
 	}
 
@@ -797,6 +852,7 @@ func (r *ApplicationResource) Create(ctx context.Context, req resource.CreateReq
 
 	diags = resp.State.Set(ctx, plan)
 	resp.Diagnostics.Append(diags...)
+	setIdentity(ctx, resp.State, resp.Identity, &resp.Diagnostics)
 }
 
 func (r *ApplicationResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
@@ -807,6 +863,8 @@ func (r *ApplicationResource) Read(ctx context.Context, req resource.ReadRequest
 		return
 	}
 
+	state.setDefaults()
+
 	app, err := r.client.GetApplication(state.ID.ValueString())
 	if err != nil {
 		if strings.Contains(err.Error(), "Not Found") || strings.Contains(err.Error(), "404") {
@@ -826,13 +884,14 @@ func (r *ApplicationResource) Read(ctx context.Context, req resource.ReadRequest
 		// Don't fail the read if traefik config can't be fetched
 		resp.Diagnostics.AddWarning("Error reading Traefik config", err.Error())
 	} else if traefikConfig != "" {
-		state.TraefikConfig = types.StringValue(traefikConfig)
+		state.TraefikConfig = NewYAMLValue(traefikConfig)
 	} else {
-		state.TraefikConfig = types.StringNull()
+		state.TraefikConfig = NewYAMLNull()
 	}
 
 	diags = resp.State.Set(ctx, state)
 	resp.Diagnostics.Append(diags...)
+	setIdentity(ctx, resp.State, resp.Identity, &resp.Diagnostics)
 }
 
 func (r *ApplicationResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
@@ -874,7 +933,11 @@ func (r *ApplicationResource) Update(ctx context.Context, req resource.UpdateReq
 	}
 
 	// 3. Update source provider settings based on source_type
-	if err := r.saveSourceProvider(appID, &plan); err != nil {
+	password := writeOnlyValue(ctx, req.Config, path.Root("password_wo"), plan.Password, &resp.Diagnostics)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	if err := r.saveSourceProvider(appID, &plan, password); err != nil {
 		resp.Diagnostics.AddError("Error saving source provider", err.Error())
 		return
 	}
@@ -914,13 +977,14 @@ func (r *ApplicationResource) Update(ctx context.Context, req resource.UpdateReq
 	if err != nil {
 		resp.Diagnostics.AddWarning("Error reading Traefik config", err.Error())
 	} else if traefikConfig != "" {
-		plan.TraefikConfig = types.StringValue(traefikConfig)
+		plan.TraefikConfig = NewYAMLValue(traefikConfig)
 	} else {
-		plan.TraefikConfig = types.StringNull()
+		plan.TraefikConfig = NewYAMLNull()
 	}
 
 	diags = resp.State.Set(ctx, plan)
 	resp.Diagnostics.Append(diags...)
+	setIdentity(ctx, resp.State, resp.Identity, &resp.Diagnostics)
 }
 
 func (r *ApplicationResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
@@ -931,7 +995,11 @@ func (r *ApplicationResource) Delete(ctx context.Context, req resource.DeleteReq
 		return
 	}
 
-	err := r.client.DeleteApplication(state.ID.ValueString())
+	if !state.checkDeletionProtection("Application", state.Name.ValueString(), &resp.Diagnostics) {
+		return
+	}
+
+	err := r.client.DeleteApplication(state.ID.ValueString(), state.DeleteVolumes.ValueBool())
 	if err != nil {
 		errStr := strings.ToLower(err.Error())
 		if strings.Contains(errStr, "not found") || strings.Contains(errStr, "not_found") || strings.Contains(errStr, "404") {
@@ -943,8 +1011,15 @@ func (r *ApplicationResource) Delete(ctx context.Context, req resource.DeleteReq
 	}
 }
 
+// ImportState accepts a raw ID or a "project/environment/app_name" path.
 func (r *ApplicationResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
-	resource.ImportStatePassthroughID(ctx, path.Root("id"), req, resp)
+	id, err := resolveServiceImportID(r.client, importStateID(ctx, req, &resp.Diagnostics), "application")
+	if err != nil {
+		resp.Diagnostics.AddError("Invalid Import ID", err.Error())
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("id"), id)...)
 }
 
 // Helper functions
@@ -1057,73 +1132,9 @@ func (r *ApplicationResource) updateGeneralSettings(appID string, plan *Applicat
 	}
 	generalApp.Enabled = plan.Enabled.ValueBool()
 
-	// Docker Swarm fields - parse JSON strings to maps
-	if !plan.HealthCheckSwarm.IsNull() && !plan.HealthCheckSwarm.IsUnknown() {
-		var m map[string]interface{}
-		if err := json.Unmarshal([]byte(plan.HealthCheckSwarm.ValueString()), &m); err != nil {
-			return fmt.Errorf("invalid JSON for health_check_swarm: %w", err)
-		}
-		generalApp.HealthCheckSwarm = m
-	}
-	if !plan.RestartPolicySwarm.IsNull() && !plan.RestartPolicySwarm.IsUnknown() {
-		var m map[string]interface{}
-		if err := json.Unmarshal([]byte(plan.RestartPolicySwarm.ValueString()), &m); err != nil {
-			return fmt.Errorf("invalid JSON for restart_policy_swarm: %w", err)
-		}
-		generalApp.RestartPolicySwarm = m
-	}
-	if !plan.PlacementSwarm.IsNull() && !plan.PlacementSwarm.IsUnknown() {
-		var m map[string]interface{}
-		if err := json.Unmarshal([]byte(plan.PlacementSwarm.ValueString()), &m); err != nil {
-			return fmt.Errorf("invalid JSON for placement_swarm: %w", err)
-		}
-		generalApp.PlacementSwarm = m
-	}
-	if !plan.UpdateConfigSwarm.IsNull() && !plan.UpdateConfigSwarm.IsUnknown() {
-		var m map[string]interface{}
-		if err := json.Unmarshal([]byte(plan.UpdateConfigSwarm.ValueString()), &m); err != nil {
-			return fmt.Errorf("invalid JSON for update_config_swarm: %w", err)
-		}
-		generalApp.UpdateConfigSwarm = m
-	}
-	if !plan.RollbackConfigSwarm.IsNull() && !plan.RollbackConfigSwarm.IsUnknown() {
-		var m map[string]interface{}
-		if err := json.Unmarshal([]byte(plan.RollbackConfigSwarm.ValueString()), &m); err != nil {
-			return fmt.Errorf("invalid JSON for rollback_config_swarm: %w", err)
-		}
-		generalApp.RollbackConfigSwarm = m
-	}
-	if !plan.ModeSwarm.IsNull() && !plan.ModeSwarm.IsUnknown() {
-		var m map[string]interface{}
-		if err := json.Unmarshal([]byte(plan.ModeSwarm.ValueString()), &m); err != nil {
-			return fmt.Errorf("invalid JSON for mode_swarm: %w", err)
-		}
-		generalApp.ModeSwarm = m
-	}
-	if !plan.LabelsSwarm.IsNull() && !plan.LabelsSwarm.IsUnknown() {
-		var m map[string]interface{}
-		if err := json.Unmarshal([]byte(plan.LabelsSwarm.ValueString()), &m); err != nil {
-			return fmt.Errorf("invalid JSON for labels_swarm: %w", err)
-		}
-		generalApp.LabelsSwarm = m
-	}
-	if !plan.NetworkSwarm.IsNull() && !plan.NetworkSwarm.IsUnknown() {
-		var arr []map[string]interface{}
-		if err := json.Unmarshal([]byte(plan.NetworkSwarm.ValueString()), &arr); err != nil {
-			return fmt.Errorf("invalid JSON for network_swarm: %w", err)
-		}
-		generalApp.NetworkSwarm = arr
-	}
-	if !plan.StopGracePeriodSwarm.IsNull() && !plan.StopGracePeriodSwarm.IsUnknown() {
-		val := plan.StopGracePeriodSwarm.ValueInt64()
-		generalApp.StopGracePeriodSwarm = &val
-	}
-	if !plan.EndpointSpecSwarm.IsNull() && !plan.EndpointSpecSwarm.IsUnknown() {
-		var m map[string]interface{}
-		if err := json.Unmarshal([]byte(plan.EndpointSpecSwarm.ValueString()), &m); err != nil {
-			return fmt.Errorf("invalid JSON for endpoint_spec_swarm: %w", err)
-		}
-		generalApp.EndpointSpecSwarm = m
+	// Docker Swarm fields
+	if err := applySwarmSettings(plan, &generalApp); err != nil {
+		return err
 	}
 
 	_, err := r.client.UpdateApplicationGeneral(generalApp)
@@ -1141,7 +1152,9 @@ func (r *ApplicationResource) saveBuildType(appID string, plan *ApplicationResou
 	)
 }
 
-func (r *ApplicationResource) saveSourceProvider(appID string, plan *ApplicationResourceModel) error {
+// saveSourceProvider configures the application's source. password is the
+// Docker registry password, resolved from password or password_wo.
+func (r *ApplicationResource) saveSourceProvider(appID string, plan *ApplicationResourceModel, password string) error {
 	sourceType := plan.SourceType.ValueString()
 
 	switch sourceType {
@@ -1229,7 +1242,7 @@ func (r *ApplicationResource) saveSourceProvider(appID string, plan *Application
 			ApplicationID: appID,
 			DockerImage:   plan.DockerImage.ValueString(),
 			Username:      plan.Username.ValueString(),
-			Password:      plan.Password.ValueString(),
+			Password:      password,
 			RegistryUrl:   plan.RegistryUrl.ValueString(),
 			RegistryId:    plan.RegistryId.ValueString(),
 		}
@@ -1484,55 +1497,8 @@ func updatePlanFromApplication(plan *ApplicationResourceModel, app *client.Appli
 	// Application status (computed)
 	plan.ApplicationStatus = types.StringValue(app.ApplicationStatus)
 
-	// Docker Swarm fields - convert maps to JSON strings
-	if app.HealthCheckSwarm != nil {
-		if jsonBytes, err := json.Marshal(app.HealthCheckSwarm); err == nil {
-			plan.HealthCheckSwarm = types.StringValue(string(jsonBytes))
-		}
-	}
-	if app.RestartPolicySwarm != nil {
-		if jsonBytes, err := json.Marshal(app.RestartPolicySwarm); err == nil {
-			plan.RestartPolicySwarm = types.StringValue(string(jsonBytes))
-		}
-	}
-	if app.PlacementSwarm != nil {
-		if jsonBytes, err := json.Marshal(app.PlacementSwarm); err == nil {
-			plan.PlacementSwarm = types.StringValue(string(jsonBytes))
-		}
-	}
-	if app.UpdateConfigSwarm != nil {
-		if jsonBytes, err := json.Marshal(app.UpdateConfigSwarm); err == nil {
-			plan.UpdateConfigSwarm = types.StringValue(string(jsonBytes))
-		}
-	}
-	if app.RollbackConfigSwarm != nil {
-		if jsonBytes, err := json.Marshal(app.RollbackConfigSwarm); err == nil {
-			plan.RollbackConfigSwarm = types.StringValue(string(jsonBytes))
-		}
-	}
-	if app.ModeSwarm != nil {
-		if jsonBytes, err := json.Marshal(app.ModeSwarm); err == nil {
-			plan.ModeSwarm = types.StringValue(string(jsonBytes))
-		}
-	}
-	if app.LabelsSwarm != nil {
-		if jsonBytes, err := json.Marshal(app.LabelsSwarm); err == nil {
-			plan.LabelsSwarm = types.StringValue(string(jsonBytes))
-		}
-	}
-	if app.NetworkSwarm != nil {
-		if jsonBytes, err := json.Marshal(app.NetworkSwarm); err == nil {
-			plan.NetworkSwarm = types.StringValue(string(jsonBytes))
-		}
-	}
-	if app.StopGracePeriodSwarm != nil {
-		plan.StopGracePeriodSwarm = types.Int64Value(*app.StopGracePeriodSwarm)
-	}
-	if app.EndpointSpecSwarm != nil {
-		if jsonBytes, err := json.Marshal(app.EndpointSpecSwarm); err == nil {
-			plan.EndpointSpecSwarm = types.StringValue(string(jsonBytes))
-		}
-	}
+	// Docker Swarm fields
+	readSwarmSettings(plan, app)
 }
 
 func readApplicationIntoState(state *ApplicationResourceModel, app *client.Application) {
@@ -1824,53 +1790,6 @@ func readApplicationIntoState(state *ApplicationResourceModel, app *client.Appli
 	// Application status (computed)
 	state.ApplicationStatus = types.StringValue(app.ApplicationStatus)
 
-	// Docker Swarm fields - convert maps to JSON strings
-	if app.HealthCheckSwarm != nil {
-		if jsonBytes, err := json.Marshal(app.HealthCheckSwarm); err == nil {
-			state.HealthCheckSwarm = types.StringValue(string(jsonBytes))
-		}
-	}
-	if app.RestartPolicySwarm != nil {
-		if jsonBytes, err := json.Marshal(app.RestartPolicySwarm); err == nil {
-			state.RestartPolicySwarm = types.StringValue(string(jsonBytes))
-		}
-	}
-	if app.PlacementSwarm != nil {
-		if jsonBytes, err := json.Marshal(app.PlacementSwarm); err == nil {
-			state.PlacementSwarm = types.StringValue(string(jsonBytes))
-		}
-	}
-	if app.UpdateConfigSwarm != nil {
-		if jsonBytes, err := json.Marshal(app.UpdateConfigSwarm); err == nil {
-			state.UpdateConfigSwarm = types.StringValue(string(jsonBytes))
-		}
-	}
-	if app.RollbackConfigSwarm != nil {
-		if jsonBytes, err := json.Marshal(app.RollbackConfigSwarm); err == nil {
-			state.RollbackConfigSwarm = types.StringValue(string(jsonBytes))
-		}
-	}
-	if app.ModeSwarm != nil {
-		if jsonBytes, err := json.Marshal(app.ModeSwarm); err == nil {
-			state.ModeSwarm = types.StringValue(string(jsonBytes))
-		}
-	}
-	if app.LabelsSwarm != nil {
-		if jsonBytes, err := json.Marshal(app.LabelsSwarm); err == nil {
-			state.LabelsSwarm = types.StringValue(string(jsonBytes))
-		}
-	}
-	if app.NetworkSwarm != nil {
-		if jsonBytes, err := json.Marshal(app.NetworkSwarm); err == nil {
-			state.NetworkSwarm = types.StringValue(string(jsonBytes))
-		}
-	}
-	if app.StopGracePeriodSwarm != nil {
-		state.StopGracePeriodSwarm = types.Int64Value(*app.StopGracePeriodSwarm)
-	}
-	if app.EndpointSpecSwarm != nil {
-		if jsonBytes, err := json.Marshal(app.EndpointSpecSwarm); err == nil {
-			state.EndpointSpecSwarm = types.StringValue(string(jsonBytes))
-		}
-	}
+	// Docker Swarm fields
+	readSwarmSettings(state, app)
 }
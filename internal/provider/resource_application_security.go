@@ -0,0 +1,209 @@
+package provider
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"github.com/ahmedali6/terraform-provider-dokploy/internal/client"
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+var _ resource.Resource = &ApplicationSecurityResource{}
+var _ resource.ResourceWithImportState = &ApplicationSecurityResource{}
+var _ resource.ResourceWithIdentity = &ApplicationSecurityResource{}
+
+func NewApplicationSecurityResource() resource.Resource {
+	return &ApplicationSecurityResource{}
+}
+
+type ApplicationSecurityResource struct {
+	client *client.DokployClient
+}
+
+type ApplicationSecurityResourceModel struct {
+	ID            types.String `tfsdk:"id"`
+	Username      types.String `tfsdk:"username"`
+	Password      types.String `tfsdk:"password"`
+	ApplicationID types.String `tfsdk:"application_id"`
+	CreatedAt     types.String `tfsdk:"created_at"`
+}
+
+func (r *ApplicationSecurityResource) Metadata(_ context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_application_security"
+}
+
+func (r *ApplicationSecurityResource) Schema(_ context.Context, _ resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Description: "Manages HTTP basic auth credentials in front of a Dokploy application.",
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				Computed:    true,
+				Description: "The unique identifier of the security entry.",
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"username": schema.StringAttribute{
+				Required:    true,
+				Description: "The basic auth username.",
+				Validators: []validator.String{
+					stringvalidator.LengthAtLeast(1),
+				},
+			},
+			"password": schema.StringAttribute{
+				Required:    true,
+				Sensitive:   true,
+				Description: "The basic auth password.",
+				Validators: []validator.String{
+					stringvalidator.LengthAtLeast(1),
+				},
+			},
+			"application_id": schema.StringAttribute{
+				Required:    true,
+				Description: "The ID of the application.",
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"created_at": schema.StringAttribute{
+				Computed:    true,
+				Description: "The creation timestamp.",
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+		},
+	}
+}
+
+func (r *ApplicationSecurityResource) IdentitySchema(_ context.Context, _ resource.IdentitySchemaRequest, resp *resource.IdentitySchemaResponse) {
+	resp.IdentitySchema = idIdentitySchema()
+}
+
+func (r *ApplicationSecurityResource) Configure(_ context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+	client, ok := req.ProviderData.(*client.DokployClient)
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Resource Configure Type",
+			fmt.Sprintf("Expected *client.DokployClient, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+		return
+	}
+	r.client = client
+}
+
+func (r *ApplicationSecurityResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	var plan ApplicationSecurityResourceModel
+	diags := req.Plan.Get(ctx, &plan)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	security := client.Security{
+		Username:      plan.Username.ValueString(),
+		Password:      plan.Password.ValueString(),
+		ApplicationID: plan.ApplicationID.ValueString(),
+	}
+
+	created, err := r.client.CreateSecurity(security)
+	if err != nil {
+		resp.Diagnostics.AddError("Error creating application security", err.Error())
+		return
+	}
+
+	plan.ID = types.StringValue(created.ID)
+	plan.CreatedAt = types.StringValue(created.CreatedAt)
+
+	diags = resp.State.Set(ctx, plan)
+	resp.Diagnostics.Append(diags...)
+	setIdentity(ctx, resp.State, resp.Identity, &resp.Diagnostics)
+}
+
+func (r *ApplicationSecurityResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	var state ApplicationSecurityResourceModel
+	diags := req.State.Get(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Credentials removed in the UI are gone from the API, so drop them from
+	// state and let the next plan recreate them.
+	security, err := r.client.GetSecurity(state.ID.ValueString())
+	if err != nil {
+		if errors.Is(err, client.ErrNotFound) {
+			resp.State.RemoveResource(ctx)
+			return
+		}
+		resp.Diagnostics.AddError("Error reading application security", err.Error())
+		return
+	}
+
+	state.Username = types.StringValue(security.Username)
+	state.Password = types.StringValue(security.Password)
+	state.ApplicationID = types.StringValue(security.ApplicationID)
+	state.CreatedAt = types.StringValue(security.CreatedAt)
+
+	diags = resp.State.Set(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+	setIdentity(ctx, resp.State, resp.Identity, &resp.Diagnostics)
+}
+
+func (r *ApplicationSecurityResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	var plan ApplicationSecurityResourceModel
+	diags := req.Plan.Get(ctx, &plan)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	security := client.Security{
+		ID:       plan.ID.ValueString(),
+		Username: plan.Username.ValueString(),
+		Password: plan.Password.ValueString(),
+	}
+
+	updated, err := r.client.UpdateSecurity(security)
+	if err != nil {
+		resp.Diagnostics.AddError("Error updating application security", err.Error())
+		return
+	}
+
+	plan.Username = types.StringValue(updated.Username)
+	plan.Password = types.StringValue(updated.Password)
+
+	diags = resp.State.Set(ctx, plan)
+	resp.Diagnostics.Append(diags...)
+	setIdentity(ctx, resp.State, resp.Identity, &resp.Diagnostics)
+}
+
+func (r *ApplicationSecurityResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	var state ApplicationSecurityResourceModel
+	diags := req.State.Get(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	err := r.client.DeleteSecurity(state.ID.ValueString())
+	if err != nil && !errors.Is(err, client.ErrNotFound) {
+		resp.Diagnostics.AddError("Error deleting application security", err.Error())
+		return
+	}
+}
+
+func (r *ApplicationSecurityResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	resource.ImportStatePassthroughWithIdentity(ctx, path.Root("id"), path.Root("id"), req, resp)
+}
@@ -0,0 +1,107 @@
+package provider
+
+import (
+	"fmt"
+	"os"
+	"testing"
+
+	"github.com/ahmedali6/terraform-provider-dokploy/internal/client"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/terraform"
+)
+
+func TestAccApplicationSecurityResource(t *testing.T) {
+	host := os.Getenv("DOKPLOY_HOST")
+	apiKey := os.Getenv("DOKPLOY_API_KEY")
+
+	if host == "" || apiKey == "" {
+		t.Skip("DOKPLOY_HOST and DOKPLOY_API_KEY must be set for acceptance tests")
+	}
+
+	var securityID string
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			// Create and Read testing
+			{
+				Config: testAccApplicationSecurityResourceConfig("staging", "s3cret"),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("dokploy_application_security.test", "username", "staging"),
+					resource.TestCheckResourceAttr("dokploy_application_security.test", "password", "s3cret"),
+					resource.TestCheckResourceAttrSet("dokploy_application_security.test", "id"),
+					resource.TestCheckResourceAttrPair("dokploy_application_security.test", "application_id", "dokploy_application.test", "id"),
+					func(s *terraform.State) error {
+						securityID = s.RootModule().Resources["dokploy_application_security.test"].Primary.ID
+						return nil
+					},
+				),
+			},
+			// Update and Read testing
+			{
+				Config: testAccApplicationSecurityResourceConfig("staging-team", "n3w-s3cret"),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("dokploy_application_security.test", "username", "staging-team"),
+					resource.TestCheckResourceAttr("dokploy_application_security.test", "password", "n3w-s3cret"),
+				),
+			},
+			// ImportState testing
+			{
+				ResourceName:      "dokploy_application_security.test",
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+			// Credentials removed outside Terraform are recreated
+			{
+				PreConfig: func() {
+					if err := client.NewDokployClient(host, apiKey).DeleteSecurity(securityID); err != nil {
+						t.Fatalf("failed to delete security out of band: %s", err)
+					}
+				},
+				Config: testAccApplicationSecurityResourceConfig("staging-team", "n3w-s3cret"),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("dokploy_application_security.test", "username", "staging-team"),
+					func(s *terraform.State) error {
+						if s.RootModule().Resources["dokploy_application_security.test"].Primary.ID == securityID {
+							return fmt.Errorf("expected security to be recreated with a new ID")
+						}
+						return nil
+					},
+				),
+			},
+		},
+	})
+}
+
+func testAccApplicationSecurityResourceConfig(username, password string) string {
+	return fmt.Sprintf(`
+provider "dokploy" {
+  host    = "%s"
+  api_key = "%s"
+}
+
+resource "dokploy_project" "test" {
+  name        = "test-security-project"
+  description = "Test project for application security tests"
+}
+
+resource "dokploy_environment" "test" {
+  project_id = dokploy_project.test.id
+  name       = "test-security-env"
+}
+
+resource "dokploy_application" "test" {
+  environment_id = dokploy_environment.test.id
+  name           = "test-security-app"
+  source_type    = "docker"
+  docker_image   = "nginx:latest"
+}
+
+resource "dokploy_application_security" "test" {
+  application_id = dokploy_application.test.id
+  username       = %q
+  password       = %q
+}
+`, os.Getenv("DOKPLOY_HOST"), os.Getenv("DOKPLOY_API_KEY"), username, password)
+}
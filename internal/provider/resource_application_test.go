@@ -3,6 +3,7 @@ package provider
 import (
 	"fmt"
 	"os"
+	"regexp"
 	"testing"
 
 	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
@@ -56,6 +57,19 @@ func TestAccApplicationResource(t *testing.T) {
 					"title",            // Not returned by API on import
 				},
 			},
+			// ImportState testing with a name path (project/environment/app_name)
+			{
+				ResourceName:      "dokploy_application.test",
+				ImportState:       true,
+				ImportStateVerify: true,
+				ImportStateId:     "test-app-project/test-app-env/test-app-updated",
+				ImportStateVerifyIgnore: []string{
+					"branch", "owner", "repository", "github_id",
+					"dockerfile_path", "docker_context_path", "docker_build_stage",
+					"deploy_on_create",
+					"title",
+				},
+			},
 		},
 	})
 }
@@ -399,6 +413,103 @@ EOT
 `, os.Getenv("DOKPLOY_HOST"), os.Getenv("DOKPLOY_API_KEY"), projectName, envName, appName, traefikConfig)
 }
 
+func TestAccApplicationResourceSwarmSettings(t *testing.T) {
+	host := os.Getenv("DOKPLOY_HOST")
+	apiKey := os.Getenv("DOKPLOY_API_KEY")
+
+	if host == "" || apiKey == "" {
+		t.Skip("DOKPLOY_HOST and DOKPLOY_API_KEY must be set for acceptance tests")
+	}
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			// Invalid values are rejected at plan time
+			{
+				Config:      testAccApplicationResourceSwarmConfig("test-swarm-project", "test-swarm-env", "test-swarm-app", "sometimes", 2),
+				ExpectError: regexp.MustCompile(`Invalid Attribute Value Match`),
+			},
+			// Create with swarm settings
+			{
+				Config: testAccApplicationResourceSwarmConfig("test-swarm-project", "test-swarm-env", "test-swarm-app", "on-failure", 2),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("dokploy_application.test", "health_check_swarm.test.#", "4"),
+					resource.TestCheckResourceAttr("dokploy_application.test", "health_check_swarm.interval", "30000000000"),
+					resource.TestCheckResourceAttr("dokploy_application.test", "restart_policy_swarm.condition", "on-failure"),
+					resource.TestCheckResourceAttr("dokploy_application.test", "placement_swarm.constraints.0", "node.role == manager"),
+					resource.TestCheckResourceAttr("dokploy_application.test", "update_config_swarm.parallelism", "1"),
+					resource.TestCheckResourceAttr("dokploy_application.test", "mode_swarm.replicas", "2"),
+					resource.TestCheckResourceAttr("dokploy_application.test", "labels_swarm.team", "platform"),
+				),
+			},
+			// Update swarm settings
+			{
+				Config: testAccApplicationResourceSwarmConfig("test-swarm-project", "test-swarm-env", "test-swarm-app", "any", 1),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("dokploy_application.test", "restart_policy_swarm.condition", "any"),
+					resource.TestCheckResourceAttr("dokploy_application.test", "mode_swarm.replicas", "1"),
+				),
+			},
+		},
+	})
+}
+
+func testAccApplicationResourceSwarmConfig(projectName, envName, appName, restartCondition string, replicas int) string {
+	return fmt.Sprintf(`
+provider "dokploy" {
+  host    = "%s"
+  api_key = "%s"
+}
+
+resource "dokploy_project" "test" {
+  name        = "%s"
+  description = "Test project for swarm settings tests"
+}
+
+resource "dokploy_environment" "test" {
+  project_id = dokploy_project.test.id
+  name       = "%s"
+}
+
+resource "dokploy_application" "test" {
+  environment_id = dokploy_environment.test.id
+  name           = "%s"
+  source_type    = "docker"
+  docker_image   = "nginx:latest"
+
+  health_check_swarm = {
+    test     = ["CMD", "curl", "-f", "http://localhost/"]
+    interval = 30000000000
+    retries  = 3
+  }
+
+  restart_policy_swarm = {
+    condition    = %q
+    max_attempts = 3
+  }
+
+  placement_swarm = {
+    constraints = ["node.role == manager"]
+  }
+
+  update_config_swarm = {
+    parallelism = 1
+    order       = "start-first"
+  }
+
+  mode_swarm = {
+    mode     = "replicated"
+    replicas = %d
+  }
+
+  labels_swarm = {
+    team = "platform"
+  }
+}
+`, os.Getenv("DOKPLOY_HOST"), os.Getenv("DOKPLOY_API_KEY"), projectName, envName, appName, restartCondition, replicas)
+}
+
 // TestAccApplicationResourceMoveEnvironment tests moving an application between environments.
 func TestAccApplicationResourceMoveEnvironment(t *testing.T) {
 	host := os.Getenv("DOKPLOY_HOST")
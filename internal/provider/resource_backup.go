@@ -21,6 +21,8 @@ import (
 
 var _ resource.Resource = &BackupResource{}
 var _ resource.ResourceWithImportState = &BackupResource{}
+var _ resource.ResourceWithIdentity = &BackupResource{}
+var _ resource.ResourceWithModifyPlan = &BackupResource{}
 
 func NewBackupResource() resource.Resource {
 	return &BackupResource{}
@@ -102,7 +104,7 @@ func (r *BackupResource) Schema(_ context.Context, _ resource.SchemaRequest, res
 			},
 			"service_name": schema.StringAttribute{
 				Optional:    true,
-				Description: "Name of the service within the compose to backup. Required when backup_type is 'compose'.",
+				Description: "Name of the service within the compose to backup. Required when backup_type is 'compose'. Checked against the services of compose_id: an unknown service is a warning at plan time, as the compose file may change in the same apply, and an error at apply time.",
 			},
 			"schedule": schema.StringAttribute{
 				Required:    true,
@@ -132,6 +134,10 @@ func (r *BackupResource) Schema(_ context.Context, _ resource.SchemaRequest, res
 	}
 }
 
+func (r *BackupResource) IdentitySchema(_ context.Context, _ resource.IdentitySchemaRequest, resp *resource.IdentitySchemaResponse) {
+	resp.IdentitySchema = idIdentitySchema()
+}
+
 func (r *BackupResource) Configure(_ context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
 	if req.ProviderData == nil {
 		return
@@ -144,6 +150,29 @@ func (r *BackupResource) Configure(_ context.Context, req resource.ConfigureRequ
 	r.client = client
 }
 
+func (r *BackupResource) ModifyPlan(ctx context.Context, req resource.ModifyPlanRequest, resp *resource.ModifyPlanResponse) {
+	// Resource is being destroyed.
+	if req.Plan.Raw.IsNull() || r.client == nil {
+		return
+	}
+
+	var plan, state BackupResourceModel
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	if !req.State.Raw.IsNull() {
+		resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	}
+	if resp.Diagnostics.HasError() || plan.BackupType.ValueString() != "compose" {
+		return
+	}
+
+	// Check the compose service exists when it changes.
+	if plan.ComposeID.IsNull() || plan.ComposeID.IsUnknown() || plan.ServiceName.IsNull() || plan.ServiceName.IsUnknown() ||
+		(plan.ComposeID.Equal(state.ComposeID) && plan.ServiceName.Equal(state.ServiceName)) {
+		return
+	}
+	checkComposeServiceName(r.client, plan.ComposeID.ValueString(), plan.ServiceName.ValueString(), false, path.Root("service_name"), &resp.Diagnostics)
+}
+
 func (r *BackupResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
 	var plan BackupResourceModel
 	diags := req.Plan.Get(ctx, &plan)
@@ -177,6 +206,10 @@ func (r *BackupResource) Create(ctx context.Context, req resource.CreateRequest,
 			resp.Diagnostics.AddError("Missing required field", "service_name is required when backup_type is 'compose'")
 			return
 		}
+		checkComposeServiceNameOnApply(ctx, r.client, plan.ComposeID, plan.ServiceName, nil, &resp.Diagnostics)
+		if resp.Diagnostics.HasError() {
+			return
+		}
 	}
 
 	backup := client.Backup{
@@ -234,6 +267,7 @@ func (r *BackupResource) Create(ctx context.Context, req resource.CreateRequest,
 
 	diags = resp.State.Set(ctx, plan)
 	resp.Diagnostics.Append(diags...)
+	setIdentity(ctx, resp.State, resp.Identity, &resp.Diagnostics)
 }
 
 func (r *BackupResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
@@ -289,6 +323,7 @@ func (r *BackupResource) Read(ctx context.Context, req resource.ReadRequest, res
 
 	diags = resp.State.Set(ctx, state)
 	resp.Diagnostics.Append(diags...)
+	setIdentity(ctx, resp.State, resp.Identity, &resp.Diagnostics)
 }
 
 func (r *BackupResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
@@ -303,6 +338,12 @@ func (r *BackupResource) Update(ctx context.Context, req resource.UpdateRequest,
 	if backupType == "" {
 		backupType = "database"
 	}
+	if backupType == "compose" {
+		checkComposeServiceNameOnApply(ctx, r.client, plan.ComposeID, plan.ServiceName, &req.State, &resp.Diagnostics)
+		if resp.Diagnostics.HasError() {
+			return
+		}
+	}
 
 	backup := client.Backup{
 		BackupID:        plan.ID.ValueString(),
@@ -344,6 +385,7 @@ func (r *BackupResource) Update(ctx context.Context, req resource.UpdateRequest,
 
 	diags = resp.State.Set(ctx, plan)
 	resp.Diagnostics.Append(diags...)
+	setIdentity(ctx, resp.State, resp.Identity, &resp.Diagnostics)
 }
 
 func (r *BackupResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
@@ -365,5 +407,5 @@ func (r *BackupResource) Delete(ctx context.Context, req resource.DeleteRequest,
 }
 
 func (r *BackupResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
-	resource.ImportStatePassthroughID(ctx, path.Root("id"), req, resp)
+	resource.ImportStatePassthroughWithIdentity(ctx, path.Root("id"), path.Root("id"), req, resp)
 }
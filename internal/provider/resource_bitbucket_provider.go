@@ -15,6 +15,7 @@ import (
 
 var _ resource.Resource = &BitbucketProviderResource{}
 var _ resource.ResourceWithImportState = &BitbucketProviderResource{}
+var _ resource.ResourceWithIdentity = &BitbucketProviderResource{}
 
 func NewBitbucketProviderResource() resource.Resource {
 	return &BitbucketProviderResource{}
@@ -108,6 +109,10 @@ func (r *BitbucketProviderResource) Schema(_ context.Context, _ resource.SchemaR
 	}
 }
 
+func (r *BitbucketProviderResource) IdentitySchema(_ context.Context, _ resource.IdentitySchemaRequest, resp *resource.IdentitySchemaResponse) {
+	resp.IdentitySchema = idIdentitySchema()
+}
+
 func (r *BitbucketProviderResource) Configure(_ context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
 	if req.ProviderData == nil {
 		return
@@ -151,6 +156,7 @@ func (r *BitbucketProviderResource) Create(ctx context.Context, req resource.Cre
 
 	diags = resp.State.Set(ctx, plan)
 	resp.Diagnostics.Append(diags...)
+	setIdentity(ctx, resp.State, resp.Identity, &resp.Diagnostics)
 }
 
 func (r *BitbucketProviderResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
@@ -188,6 +194,7 @@ func (r *BitbucketProviderResource) Read(ctx context.Context, req resource.ReadR
 
 	diags = resp.State.Set(ctx, &state)
 	resp.Diagnostics.Append(diags...)
+	setIdentity(ctx, resp.State, resp.Identity, &resp.Diagnostics)
 }
 
 func (r *BitbucketProviderResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
@@ -230,6 +237,7 @@ func (r *BitbucketProviderResource) Update(ctx context.Context, req resource.Upd
 
 	diags = resp.State.Set(ctx, plan)
 	resp.Diagnostics.Append(diags...)
+	setIdentity(ctx, resp.State, resp.Identity, &resp.Diagnostics)
 }
 
 func (r *BitbucketProviderResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
@@ -255,5 +263,5 @@ func (r *BitbucketProviderResource) Delete(ctx context.Context, req resource.Del
 }
 
 func (r *BitbucketProviderResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
-	resource.ImportStatePassthroughID(ctx, path.Root("id"), req, resp)
+	resource.ImportStatePassthroughWithIdentity(ctx, path.Root("id"), path.Root("id"), req, resp)
 }
@@ -6,17 +6,22 @@ import (
 	"strings"
 
 	"github.com/ahmedali6/terraform-provider-dokploy/internal/client"
+	"github.com/hashicorp/terraform-plugin-framework-validators/int64validator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
 	"github.com/hashicorp/terraform-plugin-framework/path"
 	"github.com/hashicorp/terraform-plugin-framework/resource"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/boolplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/int64planmodifier"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
 	"github.com/hashicorp/terraform-plugin-framework/types"
 )
 
 var _ resource.Resource = &CertificateResource{}
 var _ resource.ResourceWithImportState = &CertificateResource{}
+var _ resource.ResourceWithIdentity = &CertificateResource{}
 
 func NewCertificateResource() resource.Resource {
 	return &CertificateResource{}
@@ -27,13 +32,15 @@ type CertificateResource struct {
 }
 
 type CertificateResourceModel struct {
-	ID              types.String `tfsdk:"id"`
-	Name            types.String `tfsdk:"name"`
-	CertificateData types.String `tfsdk:"certificate_data"`
-	PrivateKey      types.String `tfsdk:"private_key"`
-	CertificatePath types.String `tfsdk:"certificate_path"`
-	AutoRenew       types.Bool   `tfsdk:"auto_renew"`
-	ServerID        types.String `tfsdk:"server_id"`
+	ID                  types.String `tfsdk:"id"`
+	Name                types.String `tfsdk:"name"`
+	CertificateData     types.String `tfsdk:"certificate_data"`
+	PrivateKey          types.String `tfsdk:"private_key"`
+	PrivateKeyWO        types.String `tfsdk:"private_key_wo"`
+	PrivateKeyWOVersion types.Int64  `tfsdk:"private_key_wo_version"`
+	CertificatePath     types.String `tfsdk:"certificate_path"`
+	AutoRenew           types.Bool   `tfsdk:"auto_renew"`
+	ServerID            types.String `tfsdk:"server_id"`
 }
 
 func (r *CertificateResource) Metadata(_ context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
@@ -67,13 +74,32 @@ func (r *CertificateResource) Schema(_ context.Context, _ resource.SchemaRequest
 				},
 			},
 			"private_key": schema.StringAttribute{
-				Required:    true,
+				Optional:    true,
 				Sensitive:   true,
-				Description: "The PEM-encoded private key.",
+				Description: "The PEM-encoded private key. Exactly one of private_key or private_key_wo must be set.",
+				Validators: []validator.String{
+					stringvalidator.ExactlyOneOf(path.MatchRoot("private_key_wo")),
+				},
 				PlanModifiers: []planmodifier.String{
 					stringplanmodifier.RequiresReplace(),
 				},
 			},
+			"private_key_wo": schema.StringAttribute{
+				Optional:    true,
+				Sensitive:   true,
+				WriteOnly:   true,
+				Description: "Write-only PEM-encoded private key. It is sent to Dokploy but never stored in plan or state. Requires Terraform 1.11 or later.",
+			},
+			"private_key_wo_version": schema.Int64Attribute{
+				Optional:    true,
+				Description: "Version of private_key_wo. Change this value to send an updated write-only value to Dokploy.",
+				Validators: []validator.Int64{
+					int64validator.AlsoRequires(path.MatchRoot("private_key_wo")),
+				},
+				PlanModifiers: []planmodifier.Int64{
+					int64planmodifier.RequiresReplace(),
+				},
+			},
 			"certificate_path": schema.StringAttribute{
 				Optional:    true,
 				Computed:    true,
@@ -101,6 +127,10 @@ func (r *CertificateResource) Schema(_ context.Context, _ resource.SchemaRequest
 	}
 }
 
+func (r *CertificateResource) IdentitySchema(_ context.Context, _ resource.IdentitySchemaRequest, resp *resource.IdentitySchemaResponse) {
+	resp.IdentitySchema = idIdentitySchema()
+}
+
 func (r *CertificateResource) Configure(_ context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
 	if req.ProviderData == nil {
 		return
@@ -121,6 +151,11 @@ func (r *CertificateResource) Create(ctx context.Context, req resource.CreateReq
 		return
 	}
 
+	privateKey := writeOnlyValue(ctx, req.Config, path.Root("private_key_wo"), plan.PrivateKey, &resp.Diagnostics)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
 	// Auto-fetch organization ID from current user
 	orgID, err := r.client.GetCurrentOrganizationID()
 	if err != nil {
@@ -131,7 +166,7 @@ func (r *CertificateResource) Create(ctx context.Context, req resource.CreateReq
 	cert := client.Certificate{
 		Name:            plan.Name.ValueString(),
 		CertificateData: plan.CertificateData.ValueString(),
-		PrivateKey:      plan.PrivateKey.ValueString(),
+		PrivateKey:      privateKey,
 		OrganizationID:  orgID,
 	}
 
@@ -160,6 +195,7 @@ func (r *CertificateResource) Create(ctx context.Context, req resource.CreateReq
 
 	diags = resp.State.Set(ctx, plan)
 	resp.Diagnostics.Append(diags...)
+	setIdentity(ctx, resp.State, resp.Identity, &resp.Diagnostics)
 }
 
 func (r *CertificateResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
@@ -195,6 +231,7 @@ func (r *CertificateResource) Read(ctx context.Context, req resource.ReadRequest
 
 	diags = resp.State.Set(ctx, state)
 	resp.Diagnostics.Append(diags...)
+	setIdentity(ctx, resp.State, resp.Identity, &resp.Diagnostics)
 }
 
 func (r *CertificateResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
@@ -225,11 +262,11 @@ func (r *CertificateResource) Delete(ctx context.Context, req resource.DeleteReq
 }
 
 func (r *CertificateResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
-	resource.ImportStatePassthroughID(ctx, path.Root("id"), req, resp)
+	resource.ImportStatePassthroughWithIdentity(ctx, path.Root("id"), path.Root("id"), req, resp)
 
 	resp.Diagnostics.AddWarning(
 		"Sensitive Data Required After Import",
-		"After importing, you must set the 'certificate_data' and 'private_key' attributes in your configuration. "+
+		"After importing, you must set the 'certificate_data' and 'private_key' (or 'private_key_wo') attributes in your configuration. "+
 			"These values cannot be securely retrieved from the server.",
 	)
 }
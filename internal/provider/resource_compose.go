@@ -21,6 +21,7 @@ import (
 
 var _ resource.Resource = &ComposeResource{}
 var _ resource.ResourceWithImportState = &ComposeResource{}
+var _ resource.ResourceWithIdentity = &ComposeResource{}
 
 func NewComposeResource() resource.Resource {
 	return &ComposeResource{}
@@ -31,6 +32,7 @@ type ComposeResource struct {
 }
 
 type ComposeResourceModel struct {
+	DeletionOptionsModel
 	ID            types.String `tfsdk:"id"`
 	EnvironmentID types.String `tfsdk:"environment_id"`
 	Name          types.String `tfsdk:"name"`
@@ -39,7 +41,7 @@ type ComposeResourceModel struct {
 	ServerID      types.String `tfsdk:"server_id"`
 
 	// Compose file
-	ComposeFileContent types.String `tfsdk:"compose_file_content"`
+	ComposeFileContent YAMLValue    `tfsdk:"compose_file_content"`
 	ComposePath        types.String `tfsdk:"compose_path"`
 	ComposeType        types.String `tfsdk:"compose_type"`
 
@@ -153,9 +155,14 @@ func (r *ComposeResource) Schema(_ context.Context, _ resource.SchemaRequest, re
 
 			// Compose file
 			"compose_file_content": schema.StringAttribute{
-				Optional:    true,
-				Computed:    true,
-				Description: "Raw docker-compose.yml content (for source_type 'raw').",
+				CustomType: YAMLType{},
+				Optional:   true,
+				Computed:   true,
+				Description: "Raw docker-compose.yml content (for source_type 'raw'). Formatting, comments and key order are ignored when comparing with the stored content. " +
+					"Checked against the Compose Specification at plan time.",
+				Validators: []validator.String{
+					composeFileValidator(),
+				},
 				PlanModifiers: []planmodifier.String{
 					stringplanmodifier.UseStateForUnknown(),
 				},
@@ -405,6 +412,13 @@ func (r *ComposeResource) Schema(_ context.Context, _ resource.SchemaRequest, re
 			},
 		},
 	}
+	for name, attr := range deletionOptionsSchemaAttributes("the Docker volumes of the compose services") {
+		resp.Schema.Attributes[name] = attr
+	}
+}
+
+func (r *ComposeResource) IdentitySchema(_ context.Context, _ resource.IdentitySchemaRequest, resp *resource.IdentitySchemaResponse) {
+	resp.IdentitySchema = idIdentitySchema()
 }
 
 func (r *ComposeResource) Configure(_ context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
@@ -553,6 +567,7 @@ func (r *ComposeResource) Create(ctx context.Context, req resource.CreateRequest
 
 	diags = resp.State.Set(ctx, plan)
 	resp.Diagnostics.Append(diags...)
+	setIdentity(ctx, resp.State, resp.Identity, &resp.Diagnostics)
 }
 
 func (r *ComposeResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
@@ -563,6 +578,8 @@ func (r *ComposeResource) Read(ctx context.Context, req resource.ReadRequest, re
 		return
 	}
 
+	state.setDefaults()
+
 	comp, err := r.client.GetCompose(state.ID.ValueString())
 	if err != nil {
 		if strings.Contains(err.Error(), "Not Found") || strings.Contains(err.Error(), "404") {
@@ -577,6 +594,7 @@ func (r *ComposeResource) Read(ctx context.Context, req resource.ReadRequest, re
 
 	diags = resp.State.Set(ctx, state)
 	resp.Diagnostics.Append(diags...)
+	setIdentity(ctx, resp.State, resp.Identity, &resp.Diagnostics)
 }
 
 func (r *ComposeResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
@@ -626,6 +644,7 @@ func (r *ComposeResource) Update(ctx context.Context, req resource.UpdateRequest
 			readComposeIntoState(ctx, &plan, movedComp, &resp.Diagnostics)
 			diags = resp.State.Set(ctx, plan)
 			resp.Diagnostics.Append(diags...)
+			setIdentity(ctx, resp.State, resp.Identity, &resp.Diagnostics)
 			return
 		}
 	}
@@ -733,6 +752,7 @@ func (r *ComposeResource) Update(ctx context.Context, req resource.UpdateRequest
 
 	diags = resp.State.Set(ctx, plan)
 	resp.Diagnostics.Append(diags...)
+	setIdentity(ctx, resp.State, resp.Identity, &resp.Diagnostics)
 }
 
 func (r *ComposeResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
@@ -743,7 +763,11 @@ func (r *ComposeResource) Delete(ctx context.Context, req resource.DeleteRequest
 		return
 	}
 
-	err := r.client.DeleteCompose(state.ID.ValueString())
+	if !state.checkDeletionProtection("Compose", state.Name.ValueString(), &resp.Diagnostics) {
+		return
+	}
+
+	err := r.client.DeleteCompose(state.ID.ValueString(), state.DeleteVolumes.ValueBool())
 	if err != nil {
 		errStr := strings.ToLower(err.Error())
 		if strings.Contains(errStr, "not found") || strings.Contains(errStr, "not_found") || strings.Contains(errStr, "404") {
@@ -755,8 +779,15 @@ func (r *ComposeResource) Delete(ctx context.Context, req resource.DeleteRequest
 	}
 }
 
+// ImportState accepts a raw ID or a "project/environment/app_name" path.
 func (r *ComposeResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
-	resource.ImportStatePassthroughID(ctx, path.Root("id"), req, resp)
+	id, err := resolveServiceImportID(r.client, importStateID(ctx, req, &resp.Diagnostics), "compose")
+	if err != nil {
+		resp.Diagnostics.AddError("Invalid Import ID", err.Error())
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("id"), id)...)
 }
 
 // Helper functions
@@ -800,9 +831,9 @@ func readComposeIntoState(ctx context.Context, state *ComposeResourceModel, comp
 
 	// Compose file
 	if comp.ComposeFile != "" {
-		state.ComposeFileContent = types.StringValue(comp.ComposeFile)
+		state.ComposeFileContent = NewYAMLValue(comp.ComposeFile)
 	} else if state.ComposeFileContent.IsUnknown() {
-		state.ComposeFileContent = types.StringNull()
+		state.ComposeFileContent = NewYAMLNull()
 	}
 	if comp.ComposePath != "" {
 		state.ComposePath = types.StringValue(comp.ComposePath)
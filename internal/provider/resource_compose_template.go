@@ -0,0 +1,452 @@
+package provider
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"github.com/ahmedali6/terraform-provider-dokploy/internal/client"
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/booldefault"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/boolplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/listplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+var _ resource.Resource = &ComposeTemplateResource{}
+var _ resource.ResourceWithImportState = &ComposeTemplateResource{}
+var _ resource.ResourceWithIdentity = &ComposeTemplateResource{}
+
+func NewComposeTemplateResource() resource.Resource {
+	return &ComposeTemplateResource{}
+}
+
+type ComposeTemplateResource struct {
+	client *client.DokployClient
+}
+
+type ComposeTemplateResourceModel struct {
+	DeletionOptionsModel
+	ID             types.String `tfsdk:"id"`
+	EnvironmentID  types.String `tfsdk:"environment_id"`
+	TemplateID     types.String `tfsdk:"template_id"`
+	ServerID       types.String `tfsdk:"server_id"`
+	BaseURL        types.String `tfsdk:"base_url"`
+	DeployOnCreate types.Bool   `tfsdk:"deploy_on_create"`
+
+	// Generated by the template
+	Name               types.String `tfsdk:"name"`
+	AppName            types.String `tfsdk:"app_name"`
+	ComposeFileContent types.String `tfsdk:"compose_file_content"`
+	Env                types.String `tfsdk:"env"`
+	Domains            types.List   `tfsdk:"domains"`
+	Mounts             types.List   `tfsdk:"mounts"`
+}
+
+type ComposeTemplateDomainModel struct {
+	ID          types.String `tfsdk:"id"`
+	Host        types.String `tfsdk:"host"`
+	Path        types.String `tfsdk:"path"`
+	Port        types.Int64  `tfsdk:"port"`
+	ServiceName types.String `tfsdk:"service_name"`
+	HTTPS       types.Bool   `tfsdk:"https"`
+}
+
+type ComposeTemplateMountModel struct {
+	ID         types.String `tfsdk:"id"`
+	Type       types.String `tfsdk:"type"`
+	MountPath  types.String `tfsdk:"mount_path"`
+	VolumeName types.String `tfsdk:"volume_name"`
+	HostPath   types.String `tfsdk:"host_path"`
+	FilePath   types.String `tfsdk:"file_path"`
+	Content    types.String `tfsdk:"content"`
+}
+
+var composeTemplateDomainAttrTypes = map[string]attr.Type{
+	"id":           types.StringType,
+	"host":         types.StringType,
+	"path":         types.StringType,
+	"port":         types.Int64Type,
+	"service_name": types.StringType,
+	"https":        types.BoolType,
+}
+
+var composeTemplateMountAttrTypes = map[string]attr.Type{
+	"id":          types.StringType,
+	"type":        types.StringType,
+	"mount_path":  types.StringType,
+	"volume_name": types.StringType,
+	"host_path":   types.StringType,
+	"file_path":   types.StringType,
+	"content":     types.StringType,
+}
+
+func (r *ComposeTemplateResource) Metadata(_ context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_compose_template"
+}
+
+func (r *ComposeTemplateResource) Schema(_ context.Context, _ resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Description: "Creates a compose stack from the Dokploy template catalog (e.g., Plausible, Umami, n8n). " +
+			"The compose file, environment, domains and mounts are generated by the template and exposed as computed attributes. " +
+			"Use dokploy_compose_templates to list the available templates.",
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				Computed:    true,
+				Description: "The ID of the created compose stack.",
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"environment_id": schema.StringAttribute{
+				Required:    true,
+				Description: "The environment to create the stack in.",
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"template_id": schema.StringAttribute{
+				Required:    true,
+				Description: "The ID of the template in the catalog (e.g., 'plausible'). Dokploy does not record the template of a stack, so an imported stack takes it from configuration without being replaced.",
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplaceIf(
+						func(_ context.Context, req planmodifier.StringRequest, resp *stringplanmodifier.RequiresReplaceIfFuncResponse) {
+							resp.RequiresReplace = !req.StateValue.IsNull()
+						},
+						"Changing the template replaces the stack, except on the first apply after import.",
+						"Changing the template replaces the stack, except on the first apply after import.",
+					),
+				},
+			},
+			"server_id": schema.StringAttribute{
+				Optional:    true,
+				Description: "ID of the remote server to deploy the stack on. Omit for the Dokploy host.",
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"base_url": schema.StringAttribute{
+				Optional:    true,
+				Description: "Base URL of a custom template catalog. Omit for the default Dokploy catalog.",
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"deploy_on_create": schema.BoolAttribute{
+				Optional:    true,
+				Computed:    true,
+				Default:     booldefault.StaticBool(false),
+				Description: "Whether to deploy the stack after creating it. Default: false.",
+				PlanModifiers: []planmodifier.Bool{
+					boolplanmodifier.RequiresReplace(),
+				},
+			},
+			"name": schema.StringAttribute{
+				Computed:    true,
+				Description: "The name of the stack, generated from the template.",
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"app_name": schema.StringAttribute{
+				Computed:    true,
+				Description: "The Docker app name of the stack.",
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"compose_file_content": schema.StringAttribute{
+				Computed:    true,
+				Description: "The compose file generated by the template.",
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"env": schema.StringAttribute{
+				Computed:    true,
+				Sensitive:   true,
+				Description: "The environment generated by the template, including generated secrets.",
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"domains": schema.ListNestedAttribute{
+				Computed:    true,
+				Description: "The domains generated by the template.",
+				PlanModifiers: []planmodifier.List{
+					listplanmodifier.UseStateForUnknown(),
+				},
+				NestedObject: schema.NestedAttributeObject{
+					Attributes: map[string]schema.Attribute{
+						"id": schema.StringAttribute{
+							Computed:    true,
+							Description: "The domain ID.",
+						},
+						"host": schema.StringAttribute{
+							Computed:    true,
+							Description: "The host name.",
+						},
+						"path": schema.StringAttribute{
+							Computed:    true,
+							Description: "The routed path.",
+						},
+						"port": schema.Int64Attribute{
+							Computed:    true,
+							Description: "The container port.",
+						},
+						"service_name": schema.StringAttribute{
+							Computed:    true,
+							Description: "The compose service the domain routes to.",
+						},
+						"https": schema.BoolAttribute{
+							Computed:    true,
+							Description: "Whether HTTPS is enabled.",
+						},
+					},
+				},
+			},
+			"mounts": schema.ListNestedAttribute{
+				Computed:    true,
+				Description: "The mounts generated by the template.",
+				PlanModifiers: []planmodifier.List{
+					listplanmodifier.UseStateForUnknown(),
+				},
+				NestedObject: schema.NestedAttributeObject{
+					Attributes: map[string]schema.Attribute{
+						"id": schema.StringAttribute{
+							Computed:    true,
+							Description: "The mount ID.",
+						},
+						"type": schema.StringAttribute{
+							Computed:    true,
+							Description: "Mount type: bind, volume or file.",
+						},
+						"mount_path": schema.StringAttribute{
+							Computed:    true,
+							Description: "Path inside the container.",
+						},
+						"volume_name": schema.StringAttribute{
+							Computed:    true,
+							Description: "Docker volume name, for volume mounts.",
+						},
+						"host_path": schema.StringAttribute{
+							Computed:    true,
+							Description: "Host path, for bind mounts.",
+						},
+						"file_path": schema.StringAttribute{
+							Computed:    true,
+							Description: "File path relative to the stack's files directory, for file mounts.",
+						},
+						"content": schema.StringAttribute{
+							Computed:    true,
+							Description: "File content, for file mounts.",
+						},
+					},
+				},
+			},
+		},
+	}
+
+	for name, attr := range deletionOptionsSchemaAttributes("the Docker volumes of the compose services") {
+		resp.Schema.Attributes[name] = attr
+	}
+}
+
+func (r *ComposeTemplateResource) IdentitySchema(_ context.Context, _ resource.IdentitySchemaRequest, resp *resource.IdentitySchemaResponse) {
+	resp.IdentitySchema = idIdentitySchema()
+}
+
+func (r *ComposeTemplateResource) Configure(_ context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+	c, ok := req.ProviderData.(*client.DokployClient)
+	if !ok {
+		resp.Diagnostics.AddError("Unexpected Resource Configure Type", fmt.Sprintf("Expected *client.DokployClient, got: %T", req.ProviderData))
+		return
+	}
+	r.client = c
+}
+
+func (r *ComposeTemplateResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	var plan ComposeTemplateResourceModel
+	diags := req.Plan.Get(ctx, &plan)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	comp, err := r.client.DeployComposeTemplate(
+		plan.EnvironmentID.ValueString(),
+		plan.TemplateID.ValueString(),
+		plan.ServerID.ValueString(),
+		plan.BaseURL.ValueString(),
+	)
+	if err != nil {
+		resp.Diagnostics.AddError("Error creating compose from template", err.Error())
+		return
+	}
+	plan.ID = types.StringValue(comp.ID)
+
+	// Save the ID first so the stack is not orphaned if reading it back fails.
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("id"), plan.ID)...)
+
+	diags, err = r.readGenerated(ctx, &plan)
+	resp.Diagnostics.Append(diags...)
+	if err != nil {
+		resp.Diagnostics.AddError("Error reading compose created from template", err.Error())
+		return
+	}
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	if plan.DeployOnCreate.ValueBool() {
+		if err := r.client.DeployCompose(plan.ID.ValueString(), plan.ServerID.ValueString()); err != nil {
+			resp.Diagnostics.AddWarning("Compose Deployment Failed", fmt.Sprintf("The stack was created but deploying it failed: %s", err))
+		}
+	}
+
+	diags = resp.State.Set(ctx, plan)
+	resp.Diagnostics.Append(diags...)
+	setIdentity(ctx, resp.State, resp.Identity, &resp.Diagnostics)
+}
+
+func (r *ComposeTemplateResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	var state ComposeTemplateResourceModel
+	diags := req.State.Get(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	state.setDefaults()
+
+	diags, err := r.readGenerated(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+	if err != nil {
+		if errors.Is(err, client.ErrNotFound) {
+			resp.State.RemoveResource(ctx)
+			return
+		}
+		resp.Diagnostics.AddError("Error reading compose", err.Error())
+		return
+	}
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	diags = resp.State.Set(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+	setIdentity(ctx, resp.State, resp.Identity, &resp.Diagnostics)
+}
+
+func (r *ComposeTemplateResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	// Only the deletion options can change in place, and they are only stored in state.
+	var plan ComposeTemplateResourceModel
+	diags := req.Plan.Get(ctx, &plan)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	diags = resp.State.Set(ctx, plan)
+	resp.Diagnostics.Append(diags...)
+	setIdentity(ctx, resp.State, resp.Identity, &resp.Diagnostics)
+}
+
+func (r *ComposeTemplateResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	var state ComposeTemplateResourceModel
+	diags := req.State.Get(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	if !state.checkDeletionProtection("Compose template stack", state.Name.ValueString(), &resp.Diagnostics) {
+		return
+	}
+
+	err := r.client.DeleteCompose(state.ID.ValueString(), state.DeleteVolumes.ValueBool())
+	if err != nil && !errors.Is(err, client.ErrNotFound) {
+		resp.Diagnostics.AddError("Error deleting compose", err.Error())
+	}
+}
+
+// ImportState accepts a raw ID or a "project/environment/app_name" path.
+// template_id and base_url are not recorded by Dokploy and are taken from
+// configuration on the next apply.
+func (r *ComposeTemplateResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	id, err := resolveServiceImportID(r.client, importStateID(ctx, req, &resp.Diagnostics), "compose")
+	if err != nil {
+		resp.Diagnostics.AddError("Invalid Import ID", err.Error())
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("id"), id)...)
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("deploy_on_create"), false)...)
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("delete_volumes"), false)...)
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("deletion_protection"), false)...)
+}
+
+// readGenerated refreshes the attributes generated by the template. API
+// errors are returned separately from conversion diagnostics so callers can
+// detect a deleted stack.
+func (r *ComposeTemplateResource) readGenerated(ctx context.Context, m *ComposeTemplateResourceModel) (diag.Diagnostics, error) {
+	var diags diag.Diagnostics
+
+	comp, err := r.client.GetCompose(m.ID.ValueString())
+	if err != nil {
+		return diags, err
+	}
+	mounts, err := r.client.GetMountsByService(comp.ID, "compose")
+	if err != nil {
+		return diags, err
+	}
+
+	m.EnvironmentID = types.StringValue(comp.EnvironmentID)
+	m.Name = types.StringValue(comp.Name)
+	m.AppName = types.StringValue(comp.AppName)
+	m.ComposeFileContent = types.StringValue(comp.ComposeFile)
+	m.Env = types.StringValue(comp.Env)
+	if comp.ServerID != "" {
+		m.ServerID = types.StringValue(comp.ServerID)
+	}
+
+	domains := make([]ComposeTemplateDomainModel, len(comp.Domains))
+	for i, d := range comp.Domains {
+		domains[i] = ComposeTemplateDomainModel{
+			ID:          types.StringValue(d.ID),
+			Host:        types.StringValue(d.Host),
+			Path:        types.StringValue(d.Path),
+			Port:        types.Int64Value(d.Port),
+			ServiceName: types.StringValue(d.ServiceName),
+			HTTPS:       types.BoolValue(d.HTTPS),
+		}
+	}
+	var d diag.Diagnostics
+	m.Domains, d = types.ListValueFrom(ctx, types.ObjectType{AttrTypes: composeTemplateDomainAttrTypes}, domains)
+	diags.Append(d...)
+
+	mountModels := make([]ComposeTemplateMountModel, len(mounts))
+	for i, mount := range mounts {
+		mountModels[i] = ComposeTemplateMountModel{
+			ID:         types.StringValue(mount.ID),
+			Type:       types.StringValue(mount.Type),
+			MountPath:  types.StringValue(mount.MountPath),
+			VolumeName: optionalString(mount.VolumeName),
+			HostPath:   optionalString(mount.HostPath),
+			FilePath:   optionalString(mount.FilePath),
+			Content:    optionalString(mount.Content),
+		}
+	}
+	m.Mounts, d = types.ListValueFrom(ctx, types.ObjectType{AttrTypes: composeTemplateMountAttrTypes}, mountModels)
+	diags.Append(d...)
+
+	return diags, nil
+}
@@ -0,0 +1,68 @@
+package provider
+
+import (
+	"fmt"
+	"os"
+	"regexp"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+)
+
+func TestAccComposeTemplateResource(t *testing.T) {
+	host := os.Getenv("DOKPLOY_HOST")
+	apiKey := os.Getenv("DOKPLOY_API_KEY")
+
+	if host == "" || apiKey == "" {
+		t.Skip("DOKPLOY_HOST and DOKPLOY_API_KEY must be set for acceptance tests")
+	}
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccComposeTemplateResourceConfig(host, apiKey),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttrSet("dokploy_compose_template.test", "id"),
+					resource.TestCheckResourceAttrSet("dokploy_compose_template.test", "app_name"),
+					resource.TestMatchResourceAttr("dokploy_compose_template.test", "compose_file_content", regexp.MustCompile(`services:`)),
+					resource.TestCheckResourceAttr("dokploy_compose_template.test", "domains.#", "1"),
+					resource.TestCheckResourceAttrSet("dokploy_compose_template.test", "domains.0.host"),
+					resource.TestCheckResourceAttrSet("dokploy_compose_template.test", "env"),
+				),
+			},
+			// ImportState testing
+			{
+				ResourceName:            "dokploy_compose_template.test",
+				ImportState:             true,
+				ImportStateVerify:       true,
+				ImportStateVerifyIgnore: []string{"template_id"}, // Not recorded by Dokploy
+			},
+		},
+	})
+}
+
+func testAccComposeTemplateResourceConfig(host, apiKey string) string {
+	return fmt.Sprintf(`
+provider "dokploy" {
+  host    = "%s"
+  api_key = "%s"
+}
+
+resource "dokploy_project" "test" {
+  name        = "test-compose-template-project"
+  description = "Test project for compose templates"
+}
+
+resource "dokploy_environment" "test" {
+  project_id = dokploy_project.test.id
+  name       = "test-compose-template-env"
+}
+
+resource "dokploy_compose_template" "test" {
+  environment_id = dokploy_environment.test.id
+  template_id    = "plausible"
+}
+`, host, apiKey)
+}
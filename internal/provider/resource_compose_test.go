@@ -3,6 +3,7 @@ package provider
 import (
 	"fmt"
 	"os"
+	"regexp"
 	"testing"
 
 	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
@@ -64,6 +65,40 @@ services:
 	})
 }
 
+func TestAccComposeResourceInvalidComposeFile(t *testing.T) {
+	host := os.Getenv("DOKPLOY_HOST")
+	apiKey := os.Getenv("DOKPLOY_API_KEY")
+
+	if host == "" || apiKey == "" {
+		t.Skip("DOKPLOY_HOST and DOKPLOY_API_KEY must be set for acceptance tests")
+	}
+
+	typoContent := `services:
+  web:
+    imgae: nginx:latest`
+
+	undeclaredVolumeContent := `services:
+  web:
+    image: nginx:latest
+    volumes:
+      - data:/usr/share/nginx/html`
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config:      testAccComposeResourceConfig("test-compose-invalid-project", "test-env", "test-compose-invalid", typoContent, false),
+				ExpectError: regexp.MustCompile(`service "web" has unknown key "imgae"`),
+			},
+			{
+				Config:      testAccComposeResourceConfig("test-compose-invalid-project", "test-env", "test-compose-invalid", undeclaredVolumeContent, false),
+				ExpectError: regexp.MustCompile(`service "web" uses undeclared volume "data"`),
+			},
+		},
+	})
+}
+
 func testAccComposeResourceConfig(projectName, envName, composeName, composeContent string, deployOnCreate bool) string {
 	return fmt.Sprintf(`
 provider "dokploy" {
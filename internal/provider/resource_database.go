@@ -0,0 +1,895 @@
+package provider
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"strings"
+
+	"github.com/ahmedali6/terraform-provider-dokploy/internal/client"
+	"github.com/hashicorp/terraform-plugin-framework-validators/int64validator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/identityschema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/booldefault"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/boolplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/int64planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/tfsdk"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-go/tftypes"
+)
+
+var _ resource.Resource = &DatabaseResource{}
+var _ resource.ResourceWithImportState = &DatabaseResource{}
+var _ resource.ResourceWithIdentity = &DatabaseResource{}
+var _ resource.ResourceWithValidateConfig = &DatabaseResource{}
+var _ resource.ResourceWithModifyPlan = &DatabaseResource{}
+
+// databaseTypes lists the database engines supported by Dokploy.
+var databaseTypes = []string{"postgres", "mysql", "mariadb", "mongo", "redis"}
+
+// databaseEngine describes the attributes a database engine supports.
+type databaseEngine struct {
+	Label           string // Display name, such as "PostgreSQL"
+	DefaultImage    string
+	HasDatabaseName bool
+	HasUser         bool
+	HasRootPassword bool
+	HasReplicaSets  bool
+	// AppNamePrefix names the prefix app_name_prefix in the typed resource,
+	// which then reports the generated name as app_name.
+	AppNamePrefix bool
+}
+
+var databaseEngines = map[string]databaseEngine{
+	"postgres": {Label: "PostgreSQL", DefaultImage: "postgres:15", HasDatabaseName: true, HasUser: true},
+	"mysql":    {Label: "MySQL", DefaultImage: "mysql:8", HasDatabaseName: true, HasUser: true, HasRootPassword: true},
+	"mariadb":  {Label: "MariaDB", DefaultImage: "mariadb:11", HasDatabaseName: true, HasUser: true, HasRootPassword: true},
+	"mongo":    {Label: "MongoDB", DefaultImage: "mongo:6", HasUser: true, HasReplicaSets: true},
+	"redis":    {Label: "Redis", DefaultImage: "the official Redis image", AppNamePrefix: true},
+}
+
+func NewDatabaseResource() resource.Resource {
+	return &DatabaseResource{}
+}
+
+// DatabaseResource implements dokploy_database and the typed database
+// resources. A typed resource, such as dokploy_postgres, has its type fixed
+// and a schema without the attributes its engine does not support; its plan,
+// state and config are converted to the dokploy_database schema so that all
+// database resources share one model.
+type DatabaseResource struct {
+	client    *client.DokployClient
+	fixedType string
+}
+
+type DatabaseResourceModel struct {
+	DeletionOptionsModel
+	ID                            types.String `tfsdk:"id"`
+	Type                          types.String `tfsdk:"type"`
+	Name                          types.String `tfsdk:"name"`
+	AppName                       types.String `tfsdk:"app_name"`
+	Description                   types.String `tfsdk:"description"`
+	DatabaseName                  types.String `tfsdk:"database_name"`
+	DatabaseUser                  types.String `tfsdk:"database_user"`
+	DatabasePassword              types.String `tfsdk:"database_password"`
+	DatabasePasswordWO            types.String `tfsdk:"database_password_wo"`
+	DatabasePasswordWOVersion     types.Int64  `tfsdk:"database_password_wo_version"`
+	DatabaseRootPassword          types.String `tfsdk:"database_root_password"`
+	DatabaseRootPasswordWO        types.String `tfsdk:"database_root_password_wo"`
+	DatabaseRootPasswordWOVersion types.Int64  `tfsdk:"database_root_password_wo_version"`
+	ReplicaSets                   types.Bool   `tfsdk:"replica_sets"`
+	DockerImage                   types.String `tfsdk:"docker_image"`
+	Command                       types.String `tfsdk:"command"`
+	Env                           types.String `tfsdk:"env"`
+	MemoryReservation             types.String `tfsdk:"memory_reservation"`
+	MemoryLimit                   types.String `tfsdk:"memory_limit"`
+	CPUReservation                types.String `tfsdk:"cpu_reservation"`
+	CPULimit                      types.String `tfsdk:"cpu_limit"`
+	ExternalPort                  types.Int64  `tfsdk:"external_port"`
+	EnvironmentID                 types.String `tfsdk:"environment_id"`
+	ApplicationStatus             types.String `tfsdk:"application_status"`
+	Replicas                      types.Int64  `tfsdk:"replicas"`
+	RotatePasswordTrigger         types.String `tfsdk:"rotate_password_trigger"`
+	ServerID                      types.String `tfsdk:"server_id"`
+	InternalHost                  types.String `tfsdk:"internal_host"`
+	InternalPort                  types.Int64  `tfsdk:"internal_port"`
+	InternalConnectionURL         types.String `tfsdk:"internal_connection_url"`
+	ExternalConnectionURL         types.String `tfsdk:"external_connection_url"`
+}
+
+func (r *DatabaseResource) Metadata(_ context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	if r.fixedType != "" {
+		resp.TypeName = req.ProviderTypeName + "_" + r.fixedType
+		return
+	}
+	resp.TypeName = req.ProviderTypeName + "_database"
+}
+
+func (r *DatabaseResource) Schema(_ context.Context, _ resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = databaseSchema(r.fixedType)
+}
+
+// databaseSchema returns the schema of dokploy_database when dbType is empty,
+// or of the typed resource of dbType, which has only the attributes its
+// engine supports.
+func databaseSchema(dbType string) schema.Schema {
+	engine, typed := databaseEngines[dbType]
+	label := "database"
+	if typed {
+		label = engine.Label
+	}
+	kind := label + " instance"
+
+	s := schema.Schema{
+		Description: "Manages a database instance of any engine supported by Dokploy. The engine is selected with type; attributes that do not apply to it are rejected at plan time.",
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				Computed:    true,
+				Description: fmt.Sprintf("Unique identifier for the %s.", kind),
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"name": schema.StringAttribute{
+				Required:    true,
+				Description: fmt.Sprintf("Name of the %s.", kind),
+			},
+			"app_name": schema.StringAttribute{
+				Required:    true,
+				Description: fmt.Sprintf("Application name prefix for the %s. Dokploy will append a random suffix, available as internal_host.", kind),
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"description": schema.StringAttribute{
+				Optional:    true,
+				Description: fmt.Sprintf("Description of the %s.", kind),
+			},
+			"database_password": schema.StringAttribute{
+				Optional:    true,
+				Computed:    true,
+				Sensitive:   true,
+				Description: "Password for the database user. Generated when neither database_password nor database_password_wo is set. Conflicts with database_password_wo.",
+				Validators: []validator.String{
+					stringvalidator.ConflictsWith(path.MatchRoot("database_password_wo")),
+				},
+				PlanModifiers: []planmodifier.String{
+					generatedPasswordModifier("database_password_wo"),
+				},
+			},
+			"database_password_wo": schema.StringAttribute{
+				Optional:    true,
+				Sensitive:   true,
+				WriteOnly:   true,
+				Description: "Write-only password for the database user. It is sent to Dokploy but never stored in plan or state. Requires Terraform 1.11 or later.",
+			},
+			"database_password_wo_version": schema.Int64Attribute{
+				Optional:    true,
+				Description: "Version of database_password_wo. Change this value to send an updated write-only value to Dokploy.",
+				Validators: []validator.Int64{
+					int64validator.AlsoRequires(path.MatchRoot("database_password_wo")),
+				},
+			},
+			"docker_image": schema.StringAttribute{
+				Optional:    true,
+				Computed:    true,
+				Description: "Docker image to use. Defaults to Dokploy's image for the engine.",
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"command": schema.StringAttribute{
+				Optional:    true,
+				Description: "Custom command to run in the container.",
+			},
+			"env": schema.StringAttribute{
+				Optional:    true,
+				Description: "Environment variables for the container.",
+			},
+			"memory_reservation": schema.StringAttribute{
+				Optional:    true,
+				Description: "Memory reservation for the container.",
+			},
+			"memory_limit": schema.StringAttribute{
+				Optional:    true,
+				Description: "Memory limit for the container.",
+			},
+			"cpu_reservation": schema.StringAttribute{
+				Optional:    true,
+				Description: "CPU reservation for the container.",
+			},
+			"cpu_limit": schema.StringAttribute{
+				Optional:    true,
+				Description: "CPU limit for the container.",
+			},
+			"external_port": schema.Int64Attribute{
+				Optional:    true,
+				Description: fmt.Sprintf("External port to expose the %s.", kind),
+			},
+			"environment_id": schema.StringAttribute{
+				Required:    true,
+				Description: fmt.Sprintf("ID of the environment to deploy the %s in. Changing it moves the instance to the new environment in place.", kind),
+			},
+			"application_status": schema.StringAttribute{
+				Computed:    true,
+				Description: fmt.Sprintf("Current status of the %s application (idle, running, done, error).", label),
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"replicas": schema.Int64Attribute{
+				Optional:    true,
+				Computed:    true,
+				Description: fmt.Sprintf("Number of replicas for the %s.", kind),
+				PlanModifiers: []planmodifier.Int64{
+					int64planmodifier.UseStateForUnknown(),
+				},
+			},
+			"rotate_password_trigger": schema.StringAttribute{
+				Optional:    true,
+				Description: "Arbitrary value that rotates generated passwords when changed. New passwords are applied through the update endpoint. Passwords set in configuration are not affected.",
+			},
+			"server_id": schema.StringAttribute{
+				Optional:    true,
+				Description: fmt.Sprintf("ID of the server to deploy the %s on.", kind),
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+		},
+	}
+	for name, attr := range databaseConnectionSchemaAttributes() {
+		s.Attributes[name] = attr
+	}
+	for name, attr := range deletionOptionsSchemaAttributes("the data volume of the " + kind) {
+		s.Attributes[name] = attr
+	}
+
+	if !typed {
+		s.Attributes["type"] = schema.StringAttribute{
+			Required:    true,
+			Description: "Database engine. One of 'postgres', 'mysql', 'mariadb', 'mongo' or 'redis'. Changing this forces a new resource.",
+			Validators: []validator.String{
+				stringvalidator.OneOf(databaseTypes...),
+			},
+			PlanModifiers: []planmodifier.String{
+				stringplanmodifier.RequiresReplace(),
+			},
+		}
+		s.Attributes["database_name"] = schema.StringAttribute{
+			Optional:    true,
+			Description: "Name of the database to create. Required for postgres, mysql and mariadb; not supported for mongo and redis.",
+			PlanModifiers: []planmodifier.String{
+				stringplanmodifier.RequiresReplace(),
+			},
+		}
+		s.Attributes["database_user"] = schema.StringAttribute{
+			Optional:    true,
+			Description: "Database user name. Required for all types except redis, which does not support it.",
+			PlanModifiers: []planmodifier.String{
+				stringplanmodifier.RequiresReplace(),
+			},
+		}
+		for name, attr := range databaseRootPasswordSchemaAttributes(
+			"Root password, for mysql and mariadb only. Generated for those types when neither database_root_password nor database_root_password_wo is set. Conflicts with database_root_password_wo.",
+			"Write-only root password, for mysql and mariadb only. It is sent to Dokploy but never stored in plan or state. Requires Terraform 1.11 or later.",
+		) {
+			s.Attributes[name] = attr
+		}
+		s.Attributes["replica_sets"] = schema.BoolAttribute{
+			Optional:    true,
+			Description: "Enable replica sets, for mongo only.",
+			PlanModifiers: []planmodifier.Bool{
+				boolplanmodifier.UseStateForUnknown(),
+			},
+		}
+		return s
+	}
+
+	s.Description = fmt.Sprintf("Manages a %s database instance in Dokploy.", engine.Label)
+	s.Attributes["docker_image"] = schema.StringAttribute{
+		Optional:    true,
+		Computed:    true,
+		Description: fmt.Sprintf("Docker image to use (defaults to %s).", engine.DefaultImage),
+		PlanModifiers: []planmodifier.String{
+			stringplanmodifier.UseStateForUnknown(),
+		},
+	}
+	if engine.HasDatabaseName {
+		s.Attributes["database_name"] = schema.StringAttribute{
+			Required:    true,
+			Description: "Name of the database to create.",
+			PlanModifiers: []planmodifier.String{
+				stringplanmodifier.RequiresReplace(),
+			},
+		}
+	}
+	if engine.HasUser {
+		s.Attributes["database_user"] = schema.StringAttribute{
+			Required:    true,
+			Description: "Database user name.",
+			PlanModifiers: []planmodifier.String{
+				stringplanmodifier.RequiresReplace(),
+			},
+		}
+	}
+	if engine.HasRootPassword {
+		for name, attr := range databaseRootPasswordSchemaAttributes(
+			fmt.Sprintf("Root password for the %s. Generated when neither database_root_password nor database_root_password_wo is set. Conflicts with database_root_password_wo.", kind),
+			"Write-only root password for the instance. It is sent to Dokploy but never stored in plan or state. Requires Terraform 1.11 or later.",
+		) {
+			s.Attributes[name] = attr
+		}
+	}
+	if engine.HasReplicaSets {
+		s.Attributes["replica_sets"] = schema.BoolAttribute{
+			Optional:    true,
+			Computed:    true,
+			Default:     booldefault.StaticBool(false),
+			Description: fmt.Sprintf("Enable replica sets for the %s.", kind),
+		}
+	}
+	if engine.AppNamePrefix {
+		// The prefix is configured as app_name_prefix, and app_name holds
+		// the name Dokploy generated from it.
+		s.Attributes["app_name_prefix"] = schema.StringAttribute{
+			Required:    true,
+			Description: fmt.Sprintf("Application name prefix for the %s. Dokploy will append a random suffix to create the final app_name.", kind),
+			PlanModifiers: []planmodifier.String{
+				stringplanmodifier.RequiresReplace(),
+			},
+		}
+		s.Attributes["app_name"] = schema.StringAttribute{
+			Computed:    true,
+			Description: "The actual application name used by Dokploy (includes server-generated suffix).",
+			PlanModifiers: []planmodifier.String{
+				stringplanmodifier.UseStateForUnknown(),
+			},
+		}
+	}
+	return s
+}
+
+// databaseRootPasswordSchemaAttributes returns the root password attributes
+// of MySQL and MariaDB.
+func databaseRootPasswordSchemaAttributes(description, writeOnlyDescription string) map[string]schema.Attribute {
+	return map[string]schema.Attribute{
+		"database_root_password": schema.StringAttribute{
+			Optional:    true,
+			Computed:    true,
+			Sensitive:   true,
+			Description: description,
+			Validators: []validator.String{
+				stringvalidator.ConflictsWith(path.MatchRoot("database_root_password_wo")),
+			},
+			PlanModifiers: []planmodifier.String{
+				generatedPasswordModifier("database_root_password_wo"),
+			},
+		},
+		"database_root_password_wo": schema.StringAttribute{
+			Optional:    true,
+			Sensitive:   true,
+			WriteOnly:   true,
+			Description: writeOnlyDescription,
+		},
+		"database_root_password_wo_version": schema.Int64Attribute{
+			Optional:    true,
+			Description: "Version of database_root_password_wo. Change this value to send an updated write-only value to Dokploy.",
+			Validators: []validator.Int64{
+				int64validator.AlsoRequires(path.MatchRoot("database_root_password_wo")),
+			},
+		},
+	}
+}
+
+func (r *DatabaseResource) IdentitySchema(_ context.Context, _ resource.IdentitySchemaRequest, resp *resource.IdentitySchemaResponse) {
+	if r.fixedType != "" {
+		resp.IdentitySchema = idIdentitySchema()
+		return
+	}
+	resp.IdentitySchema = identityschema.Schema{
+		Attributes: map[string]identityschema.Attribute{
+			"type": identityschema.StringAttribute{
+				RequiredForImport: true,
+				Description:       "Database type: postgres, mysql, mariadb, mongo or redis.",
+			},
+			"id": identityschema.StringAttribute{
+				RequiredForImport: true,
+				Description:       "The ID of the database.",
+			},
+		},
+	}
+}
+
+func (r *DatabaseResource) ValidateConfig(ctx context.Context, req resource.ValidateConfigRequest, resp *resource.ValidateConfigResponse) {
+	// The schema of a typed resource only has the attributes it supports.
+	if r.fixedType != "" {
+		return
+	}
+
+	var config DatabaseResourceModel
+	resp.Diagnostics.Append(req.Config.Get(ctx, &config)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	if config.Type.IsNull() || config.Type.IsUnknown() {
+		return
+	}
+	dbType := config.Type.ValueString()
+	engine := databaseEngines[dbType]
+
+	requireAttribute := func(name string, value attr.Value, required bool) {
+		switch {
+		case required && value.IsNull():
+			resp.Diagnostics.AddAttributeError(
+				path.Root(name),
+				"Missing Attribute Configuration",
+				fmt.Sprintf("%s is required when type is %q.", name, dbType),
+			)
+		case !required && !value.IsNull():
+			resp.Diagnostics.AddAttributeError(
+				path.Root(name),
+				"Invalid Attribute Configuration",
+				fmt.Sprintf("%s is not supported when type is %q.", name, dbType),
+			)
+		}
+	}
+
+	requireAttribute("database_name", config.DatabaseName, engine.HasDatabaseName)
+	requireAttribute("database_user", config.DatabaseUser, engine.HasUser)
+	if !engine.HasRootPassword {
+		requireAttribute("database_root_password", config.DatabaseRootPassword, false)
+		requireAttribute("database_root_password_wo", config.DatabaseRootPasswordWO, false)
+		requireAttribute("database_root_password_wo_version", config.DatabaseRootPasswordWOVersion, false)
+	}
+	if !engine.HasReplicaSets {
+		requireAttribute("replica_sets", config.ReplicaSets, false)
+	}
+}
+
+func (r *DatabaseResource) ModifyPlan(ctx context.Context, req resource.ModifyPlanRequest, resp *resource.ModifyPlanResponse) {
+	// Resource is being destroyed, or has no root password attribute.
+	if req.Plan.Raw.IsNull() || r.fixedType != "" {
+		return
+	}
+
+	var dbType types.String
+	resp.Diagnostics.Append(req.Plan.GetAttribute(ctx, path.Root("type"), &dbType)...)
+	if resp.Diagnostics.HasError() || dbType.IsUnknown() {
+		return
+	}
+
+	// Only MySQL and MariaDB have a root password to generate.
+	if !databaseEngines[dbType.ValueString()].HasRootPassword {
+		resp.Diagnostics.Append(resp.Plan.SetAttribute(ctx, path.Root("database_root_password"), types.StringNull())...)
+	}
+}
+
+func (r *DatabaseResource) Configure(_ context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+	c, ok := req.ProviderData.(*client.DokployClient)
+	if !ok {
+		resp.Diagnostics.AddError("Unexpected Resource Configure Type", fmt.Sprintf("Expected *client.DokployClient, got: %T", req.ProviderData))
+		return
+	}
+	r.client = c
+}
+
+func (r *DatabaseResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	var plan DatabaseResourceModel
+	diags := r.get(ctx, req.Plan.Raw, &plan)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	db, ok := r.databaseFromPlan(ctx, r.config(ctx, req.Config), &plan, &resp.Diagnostics)
+	if !ok {
+		return
+	}
+	db.AppName = plan.AppName.ValueString()
+	db.DatabaseName = plan.DatabaseName.ValueString()
+	db.DatabaseUser = plan.DatabaseUser.ValueString()
+	db.EnvironmentID = plan.EnvironmentID.ValueString()
+	db.ServerID = plan.ServerID.ValueString()
+
+	created, err := r.client.CreateDatabaseWithType(db)
+	if err != nil {
+		resp.Diagnostics.AddError("Error creating "+r.kind(), err.Error())
+		return
+	}
+
+	r.mapDatabaseToState(&plan, created)
+
+	if err := r.mapConnectionToState(&plan, created); err != nil {
+		resp.Diagnostics.AddError("Error resolving "+r.label()+" connection details", err.Error())
+		return
+	}
+
+	diags = r.set(ctx, &resp.State, plan)
+	resp.Diagnostics.Append(diags...)
+	setIdentity(ctx, resp.State, resp.Identity, &resp.Diagnostics)
+}
+
+func (r *DatabaseResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	var state DatabaseResourceModel
+	diags := r.get(ctx, req.State.Raw, &state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	state.setDefaults()
+
+	db, err := r.client.GetDatabase(state.ID.ValueString(), state.Type.ValueString())
+	if err != nil {
+		if errors.Is(err, client.ErrNotFound) {
+			resp.State.RemoveResource(ctx)
+			return
+		}
+		resp.Diagnostics.AddError("Error reading "+r.kind(), err.Error())
+		return
+	}
+
+	// Preserve app_name from state (user-provided prefix)
+	appNamePrefix := state.AppName
+	r.mapDatabaseToState(&state, db)
+	if !appNamePrefix.IsNull() && !appNamePrefix.IsUnknown() {
+		state.AppName = appNamePrefix
+	}
+
+	if err := r.mapConnectionToState(&state, db); err != nil {
+		resp.Diagnostics.AddError("Error resolving "+r.label()+" connection details", err.Error())
+		return
+	}
+
+	diags = r.set(ctx, &resp.State, state)
+	resp.Diagnostics.Append(diags...)
+	setIdentity(ctx, resp.State, resp.Identity, &resp.Diagnostics)
+}
+
+func (r *DatabaseResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	var plan DatabaseResourceModel
+	diags := r.get(ctx, req.Plan.Raw, &plan)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	var state DatabaseResourceModel
+	diags = r.get(ctx, req.State.Raw, &state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Move the instance first if environment_id changed
+	if !plan.EnvironmentID.Equal(state.EnvironmentID) {
+		if err := r.client.MoveDatabaseWithType(state.ID.ValueString(), plan.Type.ValueString(), plan.EnvironmentID.ValueString()); err != nil {
+			resp.Diagnostics.AddError("Error moving "+r.kind()+" to new environment", err.Error())
+			return
+		}
+	}
+
+	db, ok := r.databaseFromPlan(ctx, r.config(ctx, req.Config), &plan, &resp.Diagnostics)
+	if !ok {
+		return
+	}
+	db.ID = plan.ID.ValueString()
+
+	updated, err := r.client.UpdateDatabaseWithType(db)
+	if err != nil {
+		resp.Diagnostics.AddError("Error updating "+r.kind(), err.Error())
+		return
+	}
+
+	// Preserve app_name from plan (user-provided prefix)
+	appNamePrefix := plan.AppName
+	r.mapDatabaseToState(&plan, updated)
+	plan.AppName = appNamePrefix
+
+	if err := r.mapConnectionToState(&plan, updated); err != nil {
+		resp.Diagnostics.AddError("Error resolving "+r.label()+" connection details", err.Error())
+		return
+	}
+
+	diags = r.set(ctx, &resp.State, plan)
+	resp.Diagnostics.Append(diags...)
+	setIdentity(ctx, resp.State, resp.Identity, &resp.Diagnostics)
+}
+
+func (r *DatabaseResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	var state DatabaseResourceModel
+	diags := r.get(ctx, req.State.Raw, &state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	kind := r.kind()
+	if !state.checkDeletionProtection(strings.ToUpper(kind[:1])+kind[1:], state.Name.ValueString(), &resp.Diagnostics) {
+		return
+	}
+
+	err := r.client.DeleteDatabaseWithType(state.ID.ValueString(), state.Type.ValueString(), state.DeleteVolumes.ValueBool())
+	if err != nil {
+		if errors.Is(err, client.ErrNotFound) {
+			return
+		}
+		resp.Diagnostics.AddError("Error deleting "+kind, err.Error())
+		return
+	}
+}
+
+// ImportState imports a database using an ID of the form "type:id", for
+// example "postgres:abc123", or a "project/environment/app_name" path. The
+// typed resources take a raw ID or a path.
+func (r *DatabaseResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	if r.fixedType != "" {
+		id, err := resolveServiceImportID(r.client, importStateID(ctx, req, &resp.Diagnostics), r.fixedType)
+		if err != nil {
+			resp.Diagnostics.AddError("Invalid Import ID", err.Error())
+			return
+		}
+
+		resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("id"), id)...)
+		return
+	}
+
+	if importStateFromIdentity(ctx, req, resp) {
+		return
+	}
+
+	if isImportPath(req.ID) {
+		parts, err := splitImportPath(req.ID, 3, "project/environment/app_name")
+		if err != nil {
+			resp.Diagnostics.AddError("Invalid Import ID", err.Error())
+			return
+		}
+		service, err := resolveServicePath(r.client, parts[0], parts[1], parts[2], databaseTypes...)
+		if err != nil {
+			resp.Diagnostics.AddError("Invalid Import ID", err.Error())
+			return
+		}
+
+		resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("type"), service.Type)...)
+		resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("id"), service.ID)...)
+		return
+	}
+
+	dbType, id, found := strings.Cut(req.ID, ":")
+	if !found || id == "" {
+		resp.Diagnostics.AddError(
+			"Invalid Import ID",
+			fmt.Sprintf("Expected import ID in the format 'type:id' (e.g., 'postgres:abc123') or 'project/environment/app_name', got: %s", req.ID),
+		)
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("type"), dbType)...)
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("id"), id)...)
+}
+
+// label returns the display name of the engine of a typed resource.
+func (r *DatabaseResource) label() string {
+	if engine, ok := databaseEngines[r.fixedType]; ok {
+		return engine.Label
+	}
+	return "database"
+}
+
+// kind names the resource in error messages.
+func (r *DatabaseResource) kind() string {
+	return r.label() + " instance"
+}
+
+// get reads a plan or state into the model. For a typed resource the value
+// is first converted to the dokploy_database schema.
+func (r *DatabaseResource) get(ctx context.Context, raw tftypes.Value, model *DatabaseResourceModel) diag.Diagnostics {
+	state := tfsdk.State{Schema: databaseSchema(""), Raw: r.toDatabaseValue(ctx, raw)}
+	return state.Get(ctx, model)
+}
+
+// config returns the configuration in the dokploy_database schema, from
+// which write-only values are read.
+func (r *DatabaseResource) config(ctx context.Context, config tfsdk.Config) tfsdk.Config {
+	return tfsdk.Config{Schema: databaseSchema(""), Raw: r.toDatabaseValue(ctx, config.Raw)}
+}
+
+// set writes the model to the state, converting it to the schema of a typed
+// resource.
+func (r *DatabaseResource) set(ctx context.Context, state *tfsdk.State, model DatabaseResourceModel) diag.Diagnostics {
+	if r.fixedType == "" {
+		return state.Set(ctx, model)
+	}
+
+	s := databaseSchema("")
+	database := tfsdk.State{Schema: s, Raw: tftypes.NewValue(s.Type().TerraformType(ctx), nil)}
+	diags := database.Set(ctx, model)
+	if diags.HasError() {
+		return diags
+	}
+
+	var values map[string]tftypes.Value
+	if err := database.Raw.As(&values); err != nil {
+		diags.AddError("Error converting database state", err.Error())
+		return diags
+	}
+	objectType := state.Schema.Type().TerraformType(ctx).(tftypes.Object)
+	typed := make(map[string]tftypes.Value, len(objectType.AttributeTypes))
+	for name := range objectType.AttributeTypes {
+		source := name
+		if databaseEngines[r.fixedType].AppNamePrefix {
+			switch name {
+			case "app_name_prefix":
+				source = "app_name"
+			case "app_name":
+				source = "internal_host"
+			}
+		}
+		typed[name] = values[source]
+	}
+	state.Raw = tftypes.NewValue(objectType, typed)
+	return diags
+}
+
+// toDatabaseValue converts a plan, state or config value of a typed resource
+// to the dokploy_database schema: type is set to the fixed type and the
+// attributes the typed resource does not have are null.
+func (r *DatabaseResource) toDatabaseValue(ctx context.Context, raw tftypes.Value) tftypes.Value {
+	if r.fixedType == "" {
+		return raw
+	}
+
+	objectType := databaseSchema("").Type().TerraformType(ctx).(tftypes.Object)
+	var typed map[string]tftypes.Value
+	if raw.IsNull() || !raw.IsKnown() || raw.As(&typed) != nil {
+		return tftypes.NewValue(objectType, nil)
+	}
+
+	values := make(map[string]tftypes.Value, len(objectType.AttributeTypes))
+	for name, attrType := range objectType.AttributeTypes {
+		source := name
+		if name == "app_name" && databaseEngines[r.fixedType].AppNamePrefix {
+			source = "app_name_prefix"
+		}
+		if value, ok := typed[source]; ok {
+			values[name] = value
+		} else {
+			values[name] = tftypes.NewValue(attrType, nil)
+		}
+	}
+	values["type"] = tftypes.NewValue(tftypes.String, r.fixedType)
+	return tftypes.NewValue(objectType, values)
+}
+
+// databaseFromPlan builds the fields shared by create and update, resolving
+// generated and write-only passwords.
+func (r *DatabaseResource) databaseFromPlan(ctx context.Context, config tfsdk.Config, plan *DatabaseResourceModel, diags *diag.Diagnostics) (client.Database, bool) {
+	if err := resolvePassword(&plan.DatabasePassword); err != nil {
+		diags.AddError("Error generating database password", err.Error())
+		return client.Database{}, false
+	}
+	if err := resolvePassword(&plan.DatabaseRootPassword); err != nil {
+		diags.AddError("Error generating database root password", err.Error())
+		return client.Database{}, false
+	}
+
+	password := writeOnlyValue(ctx, config, path.Root("database_password_wo"), plan.DatabasePassword, diags)
+	rootPassword := writeOnlyValue(ctx, config, path.Root("database_root_password_wo"), plan.DatabaseRootPassword, diags)
+	if diags.HasError() {
+		return client.Database{}, false
+	}
+
+	return client.Database{
+		Type:              plan.Type.ValueString(),
+		Name:              plan.Name.ValueString(),
+		Description:       plan.Description.ValueString(),
+		Password:          password,
+		RootPassword:      rootPassword,
+		ReplicaSets:       plan.ReplicaSets.ValueBool(),
+		DockerImage:       plan.DockerImage.ValueString(),
+		Command:           plan.Command.ValueString(),
+		Env:               plan.Env.ValueString(),
+		MemoryReservation: plan.MemoryReservation.ValueString(),
+		MemoryLimit:       plan.MemoryLimit.ValueString(),
+		CPUReservation:    plan.CPUReservation.ValueString(),
+		CPULimit:          plan.CPULimit.ValueString(),
+		ExternalPort:      plan.ExternalPort.ValueInt64(),
+		Replicas:          plan.Replicas.ValueInt64(),
+	}, true
+}
+
+func (r *DatabaseResource) mapDatabaseToState(state *DatabaseResourceModel, db *client.Database) {
+	state.ID = types.StringValue(db.ID)
+	state.Name = types.StringValue(db.Name)
+	state.EnvironmentID = types.StringValue(db.EnvironmentID)
+	state.ApplicationStatus = types.StringValue(db.ApplicationStatus)
+
+	if db.DatabaseName != "" {
+		state.DatabaseName = types.StringValue(db.DatabaseName)
+	}
+	if db.DatabaseUser != "" && db.Type != "redis" {
+		state.DatabaseUser = types.StringValue(db.DatabaseUser)
+	}
+	if db.DockerImage != "" {
+		state.DockerImage = types.StringValue(db.DockerImage)
+	}
+	if db.Replicas > 0 {
+		state.Replicas = types.Int64Value(db.Replicas)
+	} else {
+		state.Replicas = types.Int64Value(1)
+	}
+	if db.Type == "mongo" && (!state.ReplicaSets.IsNull() || db.ReplicaSets) {
+		state.ReplicaSets = types.BoolValue(db.ReplicaSets)
+	}
+
+	// Optional fields
+	if !state.Description.IsNull() || db.Description != "" {
+		state.Description = types.StringValue(db.Description)
+	}
+	if !state.Command.IsNull() || db.Command != "" {
+		state.Command = types.StringValue(db.Command)
+	}
+	if !state.Env.IsNull() || db.Env != "" {
+		state.Env = types.StringValue(db.Env)
+	}
+	if !state.MemoryReservation.IsNull() || db.MemoryReservation != "" {
+		state.MemoryReservation = types.StringValue(db.MemoryReservation)
+	}
+	if !state.MemoryLimit.IsNull() || db.MemoryLimit != "" {
+		state.MemoryLimit = types.StringValue(db.MemoryLimit)
+	}
+	if !state.CPUReservation.IsNull() || db.CPUReservation != "" {
+		state.CPUReservation = types.StringValue(db.CPUReservation)
+	}
+	if !state.CPULimit.IsNull() || db.CPULimit != "" {
+		state.CPULimit = types.StringValue(db.CPULimit)
+	}
+	if !state.ExternalPort.IsNull() || db.ExternalPort > 0 {
+		state.ExternalPort = types.Int64Value(db.ExternalPort)
+	}
+	if !state.ServerID.IsNull() || db.ServerID != "" {
+		state.ServerID = types.StringValue(db.ServerID)
+	}
+}
+
+// mapConnectionToState sets the computed connection attributes. The password
+// is taken from state, so a write-only password is left out of the URLs.
+func (r *DatabaseResource) mapConnectionToState(state *DatabaseResourceModel, db *client.Database) error {
+	user := db.DatabaseUser
+	if db.Type == "redis" {
+		user = "default"
+	}
+
+	conn, err := databaseConnectionInfo{
+		Type:         db.Type,
+		AppName:      db.AppName,
+		User:         user,
+		Password:     state.DatabasePassword.ValueString(),
+		DatabaseName: db.DatabaseName,
+		ServerID:     db.ServerID,
+		ExternalPort: int(db.ExternalPort),
+		InternalPort: db.InternalPort,
+	}.resolve(r.client)
+	if err != nil {
+		return err
+	}
+
+	state.InternalHost = conn.InternalHost
+	state.InternalPort = conn.InternalPort
+	state.InternalConnectionURL = conn.InternalConnectionURL
+	state.ExternalConnectionURL = conn.ExternalConnectionURL
+	return nil
+}
@@ -0,0 +1,296 @@
+package provider
+
+import (
+	"fmt"
+	"os"
+	"regexp"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/terraform"
+)
+
+func TestAccDatabaseResource(t *testing.T) {
+	host := os.Getenv("DOKPLOY_HOST")
+	apiKey := os.Getenv("DOKPLOY_API_KEY")
+
+	if host == "" || apiKey == "" {
+		t.Skip("DOKPLOY_HOST and DOKPLOY_API_KEY must be set for acceptance tests")
+	}
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			// Create and Read testing
+			{
+				Config: testAccDatabaseResourceConfig("test-database-pg", ""),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("dokploy_database.pg", "type", "postgres"),
+					resource.TestCheckResourceAttr("dokploy_database.pg", "name", "test-database-pg"),
+					resource.TestCheckResourceAttrSet("dokploy_database.pg", "id"),
+					resource.TestCheckResourceAttrSet("dokploy_database.pg", "database_password"),
+					resource.TestCheckNoResourceAttr("dokploy_database.pg", "database_root_password"),
+					resource.TestCheckResourceAttr("dokploy_database.pg", "internal_port", "5432"),
+					resource.TestCheckResourceAttr("dokploy_database.redis", "type", "redis"),
+					resource.TestCheckResourceAttrSet("dokploy_database.redis", "id"),
+					resource.TestCheckResourceAttr("dokploy_database.redis", "internal_port", "6379"),
+					resource.TestCheckResourceAttrSet("dokploy_database.mysql", "database_root_password"),
+				),
+			},
+			// Update and Read testing
+			{
+				Config: testAccDatabaseResourceConfig("test-database-pg-updated", "Updated database"),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("dokploy_database.pg", "name", "test-database-pg-updated"),
+					resource.TestCheckResourceAttr("dokploy_database.pg", "description", "Updated database"),
+				),
+			},
+			// ImportState testing
+			{
+				ResourceName:      "dokploy_database.pg",
+				ImportState:       true,
+				ImportStateVerify: true,
+				ImportStateIdFunc: func(s *terraform.State) (string, error) {
+					rs, ok := s.RootModule().Resources["dokploy_database.pg"]
+					if !ok {
+						return "", fmt.Errorf("resource not found: dokploy_database.pg")
+					}
+					return "postgres:" + rs.Primary.ID, nil
+				},
+				ImportStateVerifyIgnore: []string{"database_password", "internal_connection_url", "external_connection_url", "app_name"},
+			},
+		},
+	})
+}
+
+func TestAccDatabaseResourceInvalidConfig(t *testing.T) {
+	host := os.Getenv("DOKPLOY_HOST")
+	apiKey := os.Getenv("DOKPLOY_API_KEY")
+
+	if host == "" || apiKey == "" {
+		t.Skip("DOKPLOY_HOST and DOKPLOY_API_KEY must be set for acceptance tests")
+	}
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccDatabaseResourceInvalidConfig(`
+  type          = "postgres"
+  database_user = "app"
+`),
+				ExpectError: regexp.MustCompile(`database_name is required when type is "postgres"`),
+			},
+			{
+				Config: testAccDatabaseResourceInvalidConfig(`
+  type                   = "redis"
+  database_root_password = "secret"
+`),
+				ExpectError: regexp.MustCompile(`database_root_password is not supported when type is "redis"`),
+			},
+		},
+	})
+}
+
+// TestAccDatabaseResourceMoveEnvironment tests moving a database between
+// environments in place.
+func TestAccDatabaseResourceMoveEnvironment(t *testing.T) {
+	if os.Getenv("DOKPLOY_HOST") == "" || os.Getenv("DOKPLOY_API_KEY") == "" {
+		t.Skip("DOKPLOY_HOST and DOKPLOY_API_KEY must be set for acceptance tests")
+	}
+
+	var databaseID string
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			// Create in first environment
+			{
+				Config: testAccDatabaseResourceMoveEnvConfig("dokploy_environment.env1.id"),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttrPair("dokploy_database.test", "environment_id", "dokploy_environment.env1", "id"),
+					func(s *terraform.State) error {
+						databaseID = s.RootModule().Resources["dokploy_database.test"].Primary.ID
+						return nil
+					},
+				),
+			},
+			// Move to second environment without replacing the database
+			{
+				Config: testAccDatabaseResourceMoveEnvConfig("dokploy_environment.env2.id"),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttrPair("dokploy_database.test", "environment_id", "dokploy_environment.env2", "id"),
+					func(s *terraform.State) error {
+						if id := s.RootModule().Resources["dokploy_database.test"].Primary.ID; id != databaseID {
+							return fmt.Errorf("expected database %s to be moved in place, got new ID %s", databaseID, id)
+						}
+						return nil
+					},
+				),
+			},
+		},
+	})
+}
+
+func testAccDatabaseResourceMoveEnvConfig(envRef string) string {
+	return fmt.Sprintf(`
+provider "dokploy" {
+  host    = "%s"
+  api_key = "%s"
+}
+
+resource "dokploy_project" "test" {
+  name        = "test-database-move-project"
+  description = "Test project for database move tests"
+}
+
+resource "dokploy_environment" "env1" {
+  project_id = dokploy_project.test.id
+  name       = "env-1"
+}
+
+resource "dokploy_environment" "env2" {
+  project_id = dokploy_project.test.id
+  name       = "env-2"
+}
+
+resource "dokploy_database" "test" {
+  type           = "redis"
+  name           = "test-database-move"
+  app_name       = "testdbmove"
+  environment_id = %s
+}
+`, os.Getenv("DOKPLOY_HOST"), os.Getenv("DOKPLOY_API_KEY"), envRef)
+}
+
+// TestAccDatabaseResourceDeletionProtection tests that a protected database
+// cannot be destroyed until protection is turned off.
+func TestAccDatabaseResourceDeletionProtection(t *testing.T) {
+	if os.Getenv("DOKPLOY_HOST") == "" || os.Getenv("DOKPLOY_API_KEY") == "" {
+		t.Skip("DOKPLOY_HOST and DOKPLOY_API_KEY must be set for acceptance tests")
+	}
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			// Create with deletion protection
+			{
+				Config: testAccDatabaseResourceDeletionProtectionConfig(true),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("dokploy_database.test", "deletion_protection", "true"),
+					resource.TestCheckResourceAttr("dokploy_database.test", "delete_volumes", "true"),
+				),
+			},
+			// Destroy is rejected while protected
+			{
+				Config:      testAccDatabaseResourceDeletionProtectionConfig(true),
+				Destroy:     true,
+				ExpectError: regexp.MustCompile("Deletion Protection Enabled"),
+			},
+			// Turn protection off so the database can be destroyed
+			{
+				Config: testAccDatabaseResourceDeletionProtectionConfig(false),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("dokploy_database.test", "deletion_protection", "false"),
+				),
+			},
+		},
+	})
+}
+
+func testAccDatabaseResourceDeletionProtectionConfig(deletionProtection bool) string {
+	return fmt.Sprintf(`
+provider "dokploy" {
+  host    = "%s"
+  api_key = "%s"
+}
+
+resource "dokploy_project" "test" {
+  name        = "test-database-protection-project"
+  description = "Test project for database deletion protection tests"
+}
+
+resource "dokploy_environment" "test" {
+  project_id = dokploy_project.test.id
+  name       = "test-database-protection-env"
+}
+
+resource "dokploy_database" "test" {
+  type                = "redis"
+  name                = "test-database-protected"
+  app_name            = "testdbprotected"
+  environment_id      = dokploy_environment.test.id
+  delete_volumes      = true
+  deletion_protection = %t
+}
+`, os.Getenv("DOKPLOY_HOST"), os.Getenv("DOKPLOY_API_KEY"), deletionProtection)
+}
+
+func testAccDatabaseResourceConfig(pgName, description string) string {
+	descriptionAttr := ""
+	if description != "" {
+		descriptionAttr = fmt.Sprintf("description = %q", description)
+	}
+
+	return fmt.Sprintf(`
+provider "dokploy" {
+  host    = "%s"
+  api_key = "%s"
+}
+
+resource "dokploy_project" "test" {
+  name        = "test-database-project"
+  description = "Test project for unified database tests"
+}
+
+resource "dokploy_environment" "test" {
+  project_id = dokploy_project.test.id
+  name       = "test-database-env"
+}
+
+resource "dokploy_database" "pg" {
+  type           = "postgres"
+  name           = "%s"
+  app_name       = "testdbpg"
+  database_name  = "testdb"
+  database_user  = "testuser"
+  environment_id = dokploy_environment.test.id
+  %s
+}
+
+resource "dokploy_database" "mysql" {
+  type           = "mysql"
+  name           = "test-database-mysql"
+  app_name       = "testdbmysql"
+  database_name  = "testdb"
+  database_user  = "testuser"
+  environment_id = dokploy_environment.test.id
+}
+
+resource "dokploy_database" "redis" {
+  type           = "redis"
+  name           = "test-database-redis"
+  app_name       = "testdbredis"
+  environment_id = dokploy_environment.test.id
+}
+`, os.Getenv("DOKPLOY_HOST"), os.Getenv("DOKPLOY_API_KEY"), pgName, descriptionAttr)
+}
+
+func testAccDatabaseResourceInvalidConfig(attrs string) string {
+	return fmt.Sprintf(`
+provider "dokploy" {
+  host    = "%s"
+  api_key = "%s"
+}
+
+resource "dokploy_database" "test" {
+  name           = "test-database-invalid"
+  app_name       = "testdbinvalid"
+  environment_id = "env-id"
+%s}
+`, os.Getenv("DOKPLOY_HOST"), os.Getenv("DOKPLOY_API_KEY"), attrs)
+}
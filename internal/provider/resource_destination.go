@@ -6,16 +6,20 @@ import (
 	"strings"
 
 	"github.com/ahmedali6/terraform-provider-dokploy/internal/client"
+	"github.com/hashicorp/terraform-plugin-framework-validators/int64validator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
 	"github.com/hashicorp/terraform-plugin-framework/path"
 	"github.com/hashicorp/terraform-plugin-framework/resource"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
 	"github.com/hashicorp/terraform-plugin-framework/types"
 )
 
 var _ resource.Resource = &DestinationResource{}
 var _ resource.ResourceWithImportState = &DestinationResource{}
+var _ resource.ResourceWithIdentity = &DestinationResource{}
 
 func NewDestinationResource() resource.Resource {
 	return &DestinationResource{}
@@ -26,15 +30,17 @@ type DestinationResource struct {
 }
 
 type DestinationResourceModel struct {
-	ID              types.String `tfsdk:"id"`
-	Name            types.String `tfsdk:"name"`
-	StorageProvider types.String `tfsdk:"storage_provider"`
-	AccessKey       types.String `tfsdk:"access_key"`
-	SecretAccessKey types.String `tfsdk:"secret_access_key"`
-	Bucket          types.String `tfsdk:"bucket"`
-	Region          types.String `tfsdk:"region"`
-	Endpoint        types.String `tfsdk:"endpoint"`
-	ServerID        types.String `tfsdk:"server_id"`
+	ID                       types.String `tfsdk:"id"`
+	Name                     types.String `tfsdk:"name"`
+	StorageProvider          types.String `tfsdk:"storage_provider"`
+	AccessKey                types.String `tfsdk:"access_key"`
+	SecretAccessKey          types.String `tfsdk:"secret_access_key"`
+	SecretAccessKeyWO        types.String `tfsdk:"secret_access_key_wo"`
+	SecretAccessKeyWOVersion types.Int64  `tfsdk:"secret_access_key_wo_version"`
+	Bucket                   types.String `tfsdk:"bucket"`
+	Region                   types.String `tfsdk:"region"`
+	Endpoint                 types.String `tfsdk:"endpoint"`
+	ServerID                 types.String `tfsdk:"server_id"`
 }
 
 func (r *DestinationResource) Metadata(_ context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
@@ -65,9 +71,25 @@ func (r *DestinationResource) Schema(_ context.Context, _ resource.SchemaRequest
 				Description: "Access key for the storage provider",
 			},
 			"secret_access_key": schema.StringAttribute{
-				Required:    true,
+				Optional:    true,
+				Sensitive:   true,
+				Description: "Secret access key for the storage provider. Exactly one of secret_access_key or secret_access_key_wo must be set.",
+				Validators: []validator.String{
+					stringvalidator.ExactlyOneOf(path.MatchRoot("secret_access_key_wo")),
+				},
+			},
+			"secret_access_key_wo": schema.StringAttribute{
+				Optional:    true,
 				Sensitive:   true,
-				Description: "Secret access key for the storage provider",
+				WriteOnly:   true,
+				Description: "Write-only secret access key for the storage provider. It is sent to Dokploy but never stored in plan or state. Requires Terraform 1.11 or later.",
+			},
+			"secret_access_key_wo_version": schema.Int64Attribute{
+				Optional:    true,
+				Description: "Version of secret_access_key_wo. Change this value to send an updated write-only value to Dokploy.",
+				Validators: []validator.Int64{
+					int64validator.AlsoRequires(path.MatchRoot("secret_access_key_wo")),
+				},
 			},
 			"bucket": schema.StringAttribute{
 				Required:    true,
@@ -89,6 +111,10 @@ func (r *DestinationResource) Schema(_ context.Context, _ resource.SchemaRequest
 	}
 }
 
+func (r *DestinationResource) IdentitySchema(_ context.Context, _ resource.IdentitySchemaRequest, resp *resource.IdentitySchemaResponse) {
+	resp.IdentitySchema = idIdentitySchema()
+}
+
 func (r *DestinationResource) Configure(_ context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
 	if req.ProviderData == nil {
 		return
@@ -109,11 +135,16 @@ func (r *DestinationResource) Create(ctx context.Context, req resource.CreateReq
 		return
 	}
 
+	secretAccessKey := writeOnlyValue(ctx, req.Config, path.Root("secret_access_key_wo"), plan.SecretAccessKey, &resp.Diagnostics)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
 	dest := client.Destination{
 		Name:            plan.Name.ValueString(),
 		Provider:        plan.StorageProvider.ValueString(),
 		AccessKey:       plan.AccessKey.ValueString(),
-		SecretAccessKey: plan.SecretAccessKey.ValueString(),
+		SecretAccessKey: secretAccessKey,
 		Bucket:          plan.Bucket.ValueString(),
 		Region:          plan.Region.ValueString(),
 		Endpoint:        plan.Endpoint.ValueString(),
@@ -145,6 +176,7 @@ func (r *DestinationResource) Create(ctx context.Context, req resource.CreateReq
 
 	diags = resp.State.Set(ctx, plan)
 	resp.Diagnostics.Append(diags...)
+	setIdentity(ctx, resp.State, resp.Identity, &resp.Diagnostics)
 }
 
 func (r *DestinationResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
@@ -180,6 +212,7 @@ func (r *DestinationResource) Read(ctx context.Context, req resource.ReadRequest
 
 	diags = resp.State.Set(ctx, state)
 	resp.Diagnostics.Append(diags...)
+	setIdentity(ctx, resp.State, resp.Identity, &resp.Diagnostics)
 }
 
 func (r *DestinationResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
@@ -190,12 +223,17 @@ func (r *DestinationResource) Update(ctx context.Context, req resource.UpdateReq
 		return
 	}
 
+	secretAccessKey := writeOnlyValue(ctx, req.Config, path.Root("secret_access_key_wo"), plan.SecretAccessKey, &resp.Diagnostics)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
 	dest := client.Destination{
 		DestinationID:   plan.ID.ValueString(),
 		Name:            plan.Name.ValueString(),
 		Provider:        plan.StorageProvider.ValueString(),
 		AccessKey:       plan.AccessKey.ValueString(),
-		SecretAccessKey: plan.SecretAccessKey.ValueString(),
+		SecretAccessKey: secretAccessKey,
 		Bucket:          plan.Bucket.ValueString(),
 		Region:          plan.Region.ValueString(),
 		Endpoint:        plan.Endpoint.ValueString(),
@@ -233,6 +271,7 @@ func (r *DestinationResource) Update(ctx context.Context, req resource.UpdateReq
 
 	diags = resp.State.Set(ctx, plan)
 	resp.Diagnostics.Append(diags...)
+	setIdentity(ctx, resp.State, resp.Identity, &resp.Diagnostics)
 }
 
 func (r *DestinationResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
@@ -254,5 +293,5 @@ func (r *DestinationResource) Delete(ctx context.Context, req resource.DeleteReq
 }
 
 func (r *DestinationResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
-	resource.ImportStatePassthroughID(ctx, path.Root("id"), req, resp)
+	resource.ImportStatePassthroughWithIdentity(ctx, path.Root("id"), path.Root("id"), req, resp)
 }
@@ -6,16 +6,25 @@ import (
 	"strings"
 
 	"github.com/ahmedali6/terraform-provider-dokploy/internal/client"
+	"github.com/hashicorp/terraform-plugin-framework-validators/int64validator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
 	"github.com/hashicorp/terraform-plugin-framework/path"
 	"github.com/hashicorp/terraform-plugin-framework/resource"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/booldefault"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/int64default"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringdefault"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
 	"github.com/hashicorp/terraform-plugin-framework/types"
 )
 
 var _ resource.Resource = &DomainResource{}
 var _ resource.ResourceWithImportState = &DomainResource{}
+var _ resource.ResourceWithIdentity = &DomainResource{}
+var _ resource.ResourceWithValidateConfig = &DomainResource{}
+var _ resource.ResourceWithModifyPlan = &DomainResource{}
 
 func NewDomainResource() resource.Resource {
 	return &DomainResource{}
@@ -26,17 +35,23 @@ type DomainResource struct {
 }
 
 type DomainResourceModel struct {
-	ID                types.String `tfsdk:"id"`
-	ApplicationID     types.String `tfsdk:"application_id"`
-	ComposeID         types.String `tfsdk:"compose_id"`
-	ServiceName       types.String `tfsdk:"service_name"`
-	Host              types.String `tfsdk:"host"`
-	Path              types.String `tfsdk:"path"`
-	Port              types.Int64  `tfsdk:"port"`
-	HTTPS             types.Bool   `tfsdk:"https"`
-	CertificateType   types.String `tfsdk:"certificate_type"`
-	GenerateTraefikMe types.Bool   `tfsdk:"generate_traefik_me"`
-	RedeployOnUpdate  types.Bool   `tfsdk:"redeploy_on_update"`
+	ID                  types.String `tfsdk:"id"`
+	ApplicationID       types.String `tfsdk:"application_id"`
+	ComposeID           types.String `tfsdk:"compose_id"`
+	PreviewDeploymentID types.String `tfsdk:"preview_deployment_id"`
+	DomainType          types.String `tfsdk:"domain_type"`
+	ServiceName         types.String `tfsdk:"service_name"`
+	Host                types.String `tfsdk:"host"`
+	Path                types.String `tfsdk:"path"`
+	InternalPath        types.String `tfsdk:"internal_path"`
+	StripPath           types.Bool   `tfsdk:"strip_path"`
+	Port                types.Int64  `tfsdk:"port"`
+	HTTPS               types.Bool   `tfsdk:"https"`
+	CertificateType     types.String `tfsdk:"certificate_type"`
+	CustomCertResolver  types.String `tfsdk:"custom_cert_resolver"`
+	GenerateTraefikMe   types.Bool   `tfsdk:"generate_traefik_me"`
+	ValidateDNS         types.String `tfsdk:"validate_dns"`
+	RedeployOnUpdate    types.Bool   `tfsdk:"redeploy_on_update"`
 }
 
 func (r *DomainResource) Metadata(_ context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
@@ -64,9 +79,29 @@ func (r *DomainResource) Schema(_ context.Context, _ resource.SchemaRequest, res
 					stringplanmodifier.RequiresReplace(),
 				},
 			},
+			"preview_deployment_id": schema.StringAttribute{
+				Optional:    true,
+				Description: "ID of the preview deployment the domain routes to. Required when domain_type is 'preview'.",
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"domain_type": schema.StringAttribute{
+				Optional:    true,
+				Computed:    true,
+				Description: "Domain type: 'application', 'compose' or 'preview'. Derived from the associated resource when omitted.",
+				Validators: []validator.String{
+					stringvalidator.OneOf("application", "compose", "preview"),
+				},
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
 			"service_name": schema.StringAttribute{
-				Optional: true,
-				Computed: true,
+				Optional:    true,
+				Computed:    true,
+				Description: "Compose service the domain routes to. Checked against the services of compose_id: an unknown service is a warning at plan time, as the compose file may change in the same apply, and an error at apply time.",
 			},
 			"host": schema.StringAttribute{
 				Optional: true,
@@ -77,27 +112,64 @@ func (r *DomainResource) Schema(_ context.Context, _ resource.SchemaRequest, res
 				},
 			},
 			"path": schema.StringAttribute{
-				Optional: true,
-				Computed: true,
+				Optional:    true,
+				Computed:    true,
+				Default:     stringdefault.StaticString("/"),
+				Description: "Path prefix matched by the router. Defaults to '/'.",
+			},
+			"internal_path": schema.StringAttribute{
+				Optional:    true,
+				Computed:    true,
+				Default:     stringdefault.StaticString("/"),
+				Description: "Path the request is forwarded to inside the container. Defaults to '/'.",
+			},
+			"strip_path": schema.BoolAttribute{
+				Optional:    true,
+				Computed:    true,
+				Default:     booldefault.StaticBool(false),
+				Description: "Strip the matched path prefix before forwarding the request. Defaults to false.",
 			},
 			"port": schema.Int64Attribute{
-				Optional: true,
-				Computed: true,
+				Optional:    true,
+				Computed:    true,
+				Default:     int64default.StaticInt64(3000),
+				Description: "Container port the domain routes to. Defaults to 3000.",
+				Validators: []validator.Int64{
+					int64validator.Between(1, 65535),
+				},
 			},
 			"https": schema.BoolAttribute{
 				Optional:    true,
 				Computed:    true,
-				Description: "Enable HTTPS for the domain.",
+				Default:     booldefault.StaticBool(true),
+				Description: "Enable HTTPS for the domain. Defaults to true.",
 			},
 			"certificate_type": schema.StringAttribute{
-				Optional:    true,
-				Computed:    true,
-				Description: "Certificate type: 'none', 'letsencrypt'. Defaults to 'letsencrypt' when https is true.",
+				Optional: true,
+				Computed: true,
+				Description: "Certificate type: 'none', 'letsencrypt' or 'custom'. Defaults to 'letsencrypt' when https is true " +
+					"and is always 'none' when https is false.",
+				Validators: []validator.String{
+					stringvalidator.OneOf("none", "letsencrypt", "custom"),
+				},
+			},
+			"custom_cert_resolver": schema.StringAttribute{
+				Optional: true,
+				Description: "Name of the Traefik certificate resolver used when certificate_type is 'custom'. " +
+					"Certificates uploaded with dokploy_certificate are served from Traefik's TLS store and need no resolver reference.",
 			},
 			"generate_traefik_me": schema.BoolAttribute{
 				Optional:    true,
 				Description: "If true, generates a traefik.me domain for the application.",
 			},
+			"validate_dns": schema.StringAttribute{
+				Optional: true,
+				Description: "Check that host resolves to the server hosting the application or compose stack, or to the Dokploy host. " +
+					"'warn' reports a mismatch as a warning and 'error' fails the plan. The check runs when the domain is created, when host or its application or compose stack changes, and when validation is turned on, and is repeated after apply as a warning. Failures to run the check are warnings in both modes.",
+				Validators: []validator.String{
+					stringvalidator.OneOf("warn", "error"),
+				},
+			},
 			"redeploy_on_update": schema.BoolAttribute{
 				Optional:    true,
 				Description: "If true, triggers a redeploy of the associated application or compose stack when the domain is created or updated.",
@@ -106,6 +178,10 @@ func (r *DomainResource) Schema(_ context.Context, _ resource.SchemaRequest, res
 	}
 }
 
+func (r *DomainResource) IdentitySchema(_ context.Context, _ resource.IdentitySchemaRequest, resp *resource.IdentitySchemaResponse) {
+	resp.IdentitySchema = idIdentitySchema()
+}
+
 func (r *DomainResource) Configure(_ context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
 	if req.ProviderData == nil {
 		return
@@ -118,6 +194,152 @@ func (r *DomainResource) Configure(_ context.Context, req resource.ConfigureRequ
 	r.client = client
 }
 
+func (r *DomainResource) ValidateConfig(ctx context.Context, req resource.ValidateConfigRequest, resp *resource.ValidateConfigResponse) {
+	var config DomainResourceModel
+	resp.Diagnostics.Append(req.Config.Get(ctx, &config)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	certificateType := config.CertificateType.ValueString()
+	if certificateType == "custom" && config.CustomCertResolver.IsNull() {
+		resp.Diagnostics.AddAttributeError(
+			path.Root("custom_cert_resolver"),
+			"Missing Required Attribute",
+			"custom_cert_resolver is required when certificate_type is \"custom\".",
+		)
+	}
+	if !config.CertificateType.IsUnknown() && certificateType != "custom" && !config.CustomCertResolver.IsNull() {
+		resp.Diagnostics.AddAttributeError(
+			path.Root("custom_cert_resolver"),
+			"Invalid Attribute Combination",
+			"custom_cert_resolver is only supported when certificate_type is \"custom\".",
+		)
+	}
+	if !config.HTTPS.IsNull() && !config.HTTPS.IsUnknown() && !config.HTTPS.ValueBool() && certificateType != "" && certificateType != "none" {
+		resp.Diagnostics.AddAttributeError(
+			path.Root("certificate_type"),
+			"Invalid Attribute Combination",
+			fmt.Sprintf("certificate_type %q is not supported when https is false.", certificateType),
+		)
+	}
+	if config.DomainType.ValueString() == "preview" && config.PreviewDeploymentID.IsNull() {
+		resp.Diagnostics.AddAttributeError(
+			path.Root("preview_deployment_id"),
+			"Missing Required Attribute",
+			"preview_deployment_id is required when domain_type is \"preview\".",
+		)
+	}
+}
+
+func (r *DomainResource) ModifyPlan(ctx context.Context, req resource.ModifyPlanRequest, resp *resource.ModifyPlanResponse) {
+	// Resource is being destroyed.
+	if req.Plan.Raw.IsNull() || r.client == nil {
+		return
+	}
+
+	var plan, state DomainResourceModel
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	if !req.State.Raw.IsNull() {
+		resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	}
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Check the compose service exists when it changes.
+	if !plan.ComposeID.IsNull() && !plan.ComposeID.IsUnknown() && !plan.ServiceName.IsNull() && !plan.ServiceName.IsUnknown() &&
+		(!plan.ComposeID.Equal(state.ComposeID) || !plan.ServiceName.Equal(state.ServiceName)) {
+		checkComposeServiceName(r.client, plan.ComposeID.ValueString(), plan.ServiceName.ValueString(), false, path.Root("service_name"), &resp.Diagnostics)
+	}
+
+	if plan.Host.IsUnknown() {
+		return
+	}
+
+	// Look for another domain routing the same host and path when either changes.
+	if !plan.Path.IsUnknown() && (!plan.Host.Equal(state.Host) || !plan.Path.Equal(state.Path)) {
+		owner, err := findDomainConflict(r.client, plan.Host.ValueString(), plan.Path.ValueString(), state.ID.ValueString())
+		if err != nil {
+			resp.Diagnostics.AddWarning("Domain Conflict Check Failed", err.Error())
+		} else if owner != "" {
+			resp.Diagnostics.AddAttributeError(
+				path.Root("host"),
+				"Domain Conflict",
+				fmt.Sprintf("%s with path %s is already routed by %s.", plan.Host.ValueString(), normalizeRoutePath(plan.Path.ValueString()), owner),
+			)
+			return
+		}
+	}
+
+	// The parent is not known until apply; the DNS check then runs afterwards.
+	if plan.ValidateDNS.IsNull() || plan.ValidateDNS.IsUnknown() || plan.ApplicationID.IsUnknown() || plan.ComposeID.IsUnknown() {
+		return
+	}
+	// Check DNS when the domain is created, when it is routed elsewhere, or
+	// when validation is turned on, rather than on every plan.
+	if !req.State.Raw.IsNull() && plan.Host.Equal(state.Host) && plan.ApplicationID.Equal(state.ApplicationID) &&
+		plan.ComposeID.Equal(state.ComposeID) && !state.ValidateDNS.IsNull() {
+		return
+	}
+	r.checkDNS(&plan, plan.ValidateDNS.ValueString() == "error", &resp.Diagnostics)
+}
+
+func (m *DomainResourceModel) toClient() client.Domain {
+	domain := client.Domain{
+		ID:                  m.ID.ValueString(),
+		ApplicationID:       m.ApplicationID.ValueString(),
+		ComposeID:           m.ComposeID.ValueString(),
+		PreviewDeploymentID: m.PreviewDeploymentID.ValueString(),
+		DomainType:          m.DomainType.ValueString(),
+		ServiceName:         m.ServiceName.ValueString(),
+		Host:                m.Host.ValueString(),
+		Path:                m.Path.ValueString(),
+		InternalPath:        m.InternalPath.ValueString(),
+		StripPath:           m.StripPath.ValueBool(),
+		Port:                m.Port.ValueInt64(),
+		HTTPS:               m.HTTPS.ValueBool(),
+		CertificateType:     m.CertificateType.ValueString(),
+	}
+	if !m.CustomCertResolver.IsNull() && !m.CustomCertResolver.IsUnknown() {
+		resolver := m.CustomCertResolver.ValueString()
+		domain.CustomCertResolver = &resolver
+	}
+	return domain
+}
+
+func (m *DomainResourceModel) fromClient(d *client.Domain) {
+	m.Host = types.StringValue(d.Host)
+	m.Path = types.StringValue(d.Path)
+	// Domains created before internal paths existed report an empty value
+	m.InternalPath = types.StringValue("/")
+	if d.InternalPath != "" {
+		m.InternalPath = types.StringValue(d.InternalPath)
+	}
+	m.StripPath = types.BoolValue(d.StripPath)
+	m.Port = types.Int64Value(d.Port)
+	m.HTTPS = types.BoolValue(d.HTTPS)
+	m.ServiceName = types.StringValue(d.ServiceName)
+	m.CertificateType = types.StringValue(d.CertificateType)
+	if d.CustomCertResolver != nil && *d.CustomCertResolver != "" {
+		m.CustomCertResolver = types.StringValue(*d.CustomCertResolver)
+	} else {
+		m.CustomCertResolver = types.StringNull()
+	}
+	if d.DomainType != "" {
+		m.DomainType = types.StringValue(d.DomainType)
+	}
+	if d.ApplicationID != "" {
+		m.ApplicationID = types.StringValue(d.ApplicationID)
+	}
+	if d.ComposeID != "" {
+		m.ComposeID = types.StringValue(d.ComposeID)
+	}
+	if d.PreviewDeploymentID != "" {
+		m.PreviewDeploymentID = types.StringValue(d.PreviewDeploymentID)
+	}
+}
+
 func (r *DomainResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
 	var plan DomainResourceModel
 	diags := req.Plan.Get(ctx, &plan)
@@ -126,8 +348,13 @@ func (r *DomainResource) Create(ctx context.Context, req resource.CreateRequest,
 		return
 	}
 
-	if plan.ApplicationID.IsNull() && plan.ComposeID.IsNull() {
-		resp.Diagnostics.AddError("Missing Association", "Either application_id or compose_id must be provided")
+	if plan.ApplicationID.IsNull() && plan.ComposeID.IsNull() && plan.PreviewDeploymentID.IsNull() {
+		resp.Diagnostics.AddError("Missing Association", "One of application_id, compose_id or preview_deployment_id must be provided")
+		return
+	}
+
+	checkComposeServiceNameOnApply(ctx, r.client, plan.ComposeID, plan.ServiceName, nil, &resp.Diagnostics)
+	if resp.Diagnostics.HasError() {
 		return
 	}
 
@@ -163,29 +390,18 @@ func (r *DomainResource) Create(ctx context.Context, req resource.CreateRequest,
 		}
 	}
 
-	// Apply defaults
-	if plan.Path.IsUnknown() || plan.Path.IsNull() {
-		plan.Path = types.StringValue("/")
-	}
-	if plan.Port.IsUnknown() || plan.Port.IsNull() {
-		plan.Port = types.Int64Value(3000)
-	}
-	if plan.HTTPS.IsUnknown() || plan.HTTPS.IsNull() {
-		plan.HTTPS = types.BoolValue(true)
-	}
-
-	domain := client.Domain{
-		ApplicationID:   plan.ApplicationID.ValueString(),
-		ComposeID:       plan.ComposeID.ValueString(),
-		ServiceName:     plan.ServiceName.ValueString(),
-		Host:            plan.Host.ValueString(),
-		Path:            plan.Path.ValueString(),
-		Port:            plan.Port.ValueInt64(),
-		HTTPS:           plan.HTTPS.ValueBool(),
-		CertificateType: plan.CertificateType.ValueString(),
+	if plan.DomainType.IsUnknown() || plan.DomainType.IsNull() {
+		switch {
+		case !plan.PreviewDeploymentID.IsNull():
+			plan.DomainType = types.StringValue("preview")
+		case !plan.ComposeID.IsNull():
+			plan.DomainType = types.StringValue("compose")
+		default:
+			plan.DomainType = types.StringValue("application")
+		}
 	}
 
-	createdDomain, err := r.client.CreateDomain(domain)
+	createdDomain, err := r.client.CreateDomain(plan.toClient())
 	if err != nil {
 		resp.Diagnostics.AddError("Error creating domain", err.Error())
 		return
@@ -195,6 +411,10 @@ func (r *DomainResource) Create(ctx context.Context, req resource.CreateRequest,
 	plan.ServiceName = types.StringValue(createdDomain.ServiceName)
 	plan.CertificateType = types.StringValue(createdDomain.CertificateType)
 
+	if !plan.ValidateDNS.IsNull() {
+		r.checkDNS(&plan, false, &resp.Diagnostics)
+	}
+
 	// Trigger Redeploy if requested
 	if !plan.RedeployOnUpdate.IsNull() && plan.RedeployOnUpdate.ValueBool() {
 		if !plan.ApplicationID.IsNull() {
@@ -206,6 +426,7 @@ func (r *DomainResource) Create(ctx context.Context, req resource.CreateRequest,
 
 	diags = resp.State.Set(ctx, plan)
 	resp.Diagnostics.Append(diags...)
+	setIdentity(ctx, resp.State, resp.Identity, &resp.Diagnostics)
 }
 
 func (r *DomainResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
@@ -218,9 +439,17 @@ func (r *DomainResource) Read(ctx context.Context, req resource.ReadRequest, res
 
 	var domains []client.Domain
 	var err error
-	if !state.ApplicationID.IsNull() {
+	switch {
+	case !state.PreviewDeploymentID.IsNull():
+		// Preview domains are not listed with their application's domains
+		var domain *client.Domain
+		domain, err = r.client.GetDomain(state.ID.ValueString())
+		if domain != nil {
+			domains = []client.Domain{*domain}
+		}
+	case !state.ApplicationID.IsNull():
 		domains, err = r.client.GetDomainsByApplication(state.ApplicationID.ValueString())
-	} else {
+	default:
 		domains, err = r.client.GetDomainsByCompose(state.ComposeID.ValueString())
 	}
 
@@ -236,18 +465,7 @@ func (r *DomainResource) Read(ctx context.Context, req resource.ReadRequest, res
 	found := false
 	for _, d := range domains {
 		if d.ID == state.ID.ValueString() {
-			state.Host = types.StringValue(d.Host)
-			state.Path = types.StringValue(d.Path)
-			state.Port = types.Int64Value(d.Port)
-			state.HTTPS = types.BoolValue(d.HTTPS)
-			state.ServiceName = types.StringValue(d.ServiceName)
-			state.CertificateType = types.StringValue(d.CertificateType)
-			if d.ApplicationID != "" {
-				state.ApplicationID = types.StringValue(d.ApplicationID)
-			}
-			if d.ComposeID != "" {
-				state.ComposeID = types.StringValue(d.ComposeID)
-			}
+			state.fromClient(&d)
 			found = true
 			break
 		}
@@ -260,6 +478,7 @@ func (r *DomainResource) Read(ctx context.Context, req resource.ReadRequest, res
 
 	diags = resp.State.Set(ctx, state)
 	resp.Diagnostics.Append(diags...)
+	setIdentity(ctx, resp.State, resp.Identity, &resp.Diagnostics)
 }
 
 func (r *DomainResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
@@ -270,30 +489,22 @@ func (r *DomainResource) Update(ctx context.Context, req resource.UpdateRequest,
 		return
 	}
 
-	domain := client.Domain{
-		ID:              plan.ID.ValueString(),
-		ApplicationID:   plan.ApplicationID.ValueString(),
-		ComposeID:       plan.ComposeID.ValueString(),
-		ServiceName:     plan.ServiceName.ValueString(),
-		Host:            plan.Host.ValueString(),
-		Path:            plan.Path.ValueString(),
-		Port:            plan.Port.ValueInt64(),
-		HTTPS:           plan.HTTPS.ValueBool(),
-		CertificateType: plan.CertificateType.ValueString(),
-	}
-
-	updatedDomain, err := r.client.UpdateDomain(domain)
+	checkComposeServiceNameOnApply(ctx, r.client, plan.ComposeID, plan.ServiceName, &req.State, &resp.Diagnostics)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	updatedDomain, err := r.client.UpdateDomain(plan.toClient())
 	if err != nil {
 		resp.Diagnostics.AddError("Error updating domain", err.Error())
 		return
 	}
 
-	plan.Host = types.StringValue(updatedDomain.Host)
-	plan.Path = types.StringValue(updatedDomain.Path)
-	plan.Port = types.Int64Value(updatedDomain.Port)
-	plan.HTTPS = types.BoolValue(updatedDomain.HTTPS)
-	plan.ServiceName = types.StringValue(updatedDomain.ServiceName)
-	plan.CertificateType = types.StringValue(updatedDomain.CertificateType)
+	plan.fromClient(updatedDomain)
+
+	if !plan.ValidateDNS.IsNull() {
+		r.checkDNS(&plan, false, &resp.Diagnostics)
+	}
 
 	// Trigger Redeploy if requested
 	if !plan.RedeployOnUpdate.IsNull() && plan.RedeployOnUpdate.ValueBool() {
@@ -306,6 +517,7 @@ func (r *DomainResource) Update(ctx context.Context, req resource.UpdateRequest,
 
 	diags = resp.State.Set(ctx, plan)
 	resp.Diagnostics.Append(diags...)
+	setIdentity(ctx, resp.State, resp.Identity, &resp.Diagnostics)
 }
 
 func (r *DomainResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
@@ -329,45 +541,53 @@ func (r *DomainResource) Delete(ctx context.Context, req resource.DeleteRequest,
 }
 
 func (r *DomainResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
-	// Import format: <id> or <type>:<parent-id>:<id>
+	// Import format: <type>:<parent-id>:<id>, project/environment/app/host/path or <id>
 	// Where type is "application" or "compose"
-	importID := req.ID
-
-	// Just set the ID and let the Read function fail if we can't find it
-	// The Read function will need to be updated to handle this case
-	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("id"), importID)...)
+	importID := importStateID(ctx, req, &resp.Diagnostics)
 
-	// We can't determine application_id or compose_id from just the domain ID
-	// The user should import using format: application:<app-id>:<domain-id> or compose:<compose-id>:<domain-id>
-	// For now, set dummy values and let Read try to find it
-	// Actually, this won't work. We need a different approach.
-
-	// Try to parse the import ID
-	var parentType, parentID, domainID string
-
-	parts := strings.Split(importID, ":")
-	if len(parts) == 3 {
+	var parentType, parentID, domainID, previewDeploymentID string
+	switch parts := strings.Split(importID, ":"); {
+	case isImportPath(importID):
+		service, domain, err := resolveDomainPath(r.client, importID)
+		if err != nil {
+			resp.Diagnostics.AddError("Invalid import ID format", err.Error())
+			return
+		}
+		parentType, parentID, domainID = service.Type, service.ID, domain.ID
+	case len(parts) == 3:
 		// Format: application:app-id:domain-id or compose:compose-id:domain-id
 		parentType = parts[0]
 		parentID = parts[1]
 		domainID = parts[2]
-	} else if len(parts) == 1 {
-		// Just domain ID - we need to search for it
-		// This is not ideal but we'll return an error asking for proper format
-		resp.Diagnostics.AddError(
-			"Invalid import ID format",
-			fmt.Sprintf("Please use format 'application:<app-id>:<domain-id>' or 'compose:<compose-id>:<domain-id>'. Got: %s", importID),
-		)
-		return
-	} else {
+	case len(parts) == 1:
+		// Just the domain ID - look up its parent
+		domain, err := r.client.GetDomain(importID)
+		if err != nil {
+			resp.Diagnostics.AddError(
+				"Invalid import ID format",
+				fmt.Sprintf("Unable to read domain %s: %s. Use the format 'application:<app-id>:<domain-id>' or 'compose:<compose-id>:<domain-id>' instead.", importID, err),
+			)
+			return
+		}
+		domainID = domain.ID
+		previewDeploymentID = domain.PreviewDeploymentID
+		if domain.ComposeID != "" {
+			parentType, parentID = "compose", domain.ComposeID
+		} else {
+			parentType, parentID = "application", domain.ApplicationID
+		}
+	default:
 		resp.Diagnostics.AddError(
 			"Invalid import ID format",
-			fmt.Sprintf("Expected format 'application:<app-id>:<domain-id>' or 'compose:<compose-id>:<domain-id>'. Got: %s", importID),
+			fmt.Sprintf("Expected format 'application:<app-id>:<domain-id>', 'compose:<compose-id>:<domain-id>' or 'project/environment/app/host/path'. Got: %s", importID),
 		)
 		return
 	}
 
 	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("id"), domainID)...)
+	if previewDeploymentID != "" {
+		resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("preview_deployment_id"), previewDeploymentID)...)
+	}
 
 	switch parentType {
 	case "application":
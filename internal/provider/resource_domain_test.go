@@ -3,6 +3,7 @@ package provider
 import (
 	"fmt"
 	"os"
+	"regexp"
 	"testing"
 
 	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
@@ -57,6 +58,13 @@ func TestAccDomainResource(t *testing.T) {
 					return fmt.Sprintf("application:%s:%s", appID, domainID), nil
 				},
 			},
+			// ImportState testing with a name path (project/environment/app/host/path)
+			{
+				ResourceName:      "dokploy_domain.test",
+				ImportState:       true,
+				ImportStateVerify: true,
+				ImportStateId:     "test-domain-project/test-domain-env/test-domain-app/updated.example.com/",
+			},
 		},
 	})
 }
@@ -115,6 +123,143 @@ func TestAccDomainResourceWithTraefikMe(t *testing.T) {
 	})
 }
 
+func TestAccDomainResourceRoutingOptions(t *testing.T) {
+	host := os.Getenv("DOKPLOY_HOST")
+	apiKey := os.Getenv("DOKPLOY_API_KEY")
+
+	if host == "" || apiKey == "" {
+		t.Skip("DOKPLOY_HOST and DOKPLOY_API_KEY must be set for acceptance tests")
+	}
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			// Custom certificates need a resolver
+			{
+				Config:      testAccDomainResourceRoutingConfig(`certificate_type = "custom"`),
+				PlanOnly:    true,
+				ExpectError: regexp.MustCompile(`custom_cert_resolver is required when certificate_type is "custom"`),
+			},
+			// Certificates need HTTPS
+			{
+				Config: testAccDomainResourceRoutingConfig(`
+  https            = false
+  certificate_type = "letsencrypt"`),
+				PlanOnly:    true,
+				ExpectError: regexp.MustCompile(`certificate_type "letsencrypt" is not supported when https is false`),
+			},
+			// Path routing with defaults for port and https
+			{
+				Config: testAccDomainResourceRoutingConfig(`
+  path          = "/api"
+  internal_path = "/v1"
+  strip_path    = true`),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("dokploy_domain.test", "path", "/api"),
+					resource.TestCheckResourceAttr("dokploy_domain.test", "internal_path", "/v1"),
+					resource.TestCheckResourceAttr("dokploy_domain.test", "strip_path", "true"),
+					resource.TestCheckResourceAttr("dokploy_domain.test", "port", "3000"),
+					resource.TestCheckResourceAttr("dokploy_domain.test", "https", "true"),
+					resource.TestCheckResourceAttr("dokploy_domain.test", "certificate_type", "letsencrypt"),
+					resource.TestCheckResourceAttr("dokploy_domain.test", "domain_type", "application"),
+				),
+			},
+			// Switch to a custom certificate resolver
+			{
+				Config: testAccDomainResourceRoutingConfig(`
+  certificate_type     = "custom"
+  custom_cert_resolver = "tfresolver"`),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("dokploy_domain.test", "path", "/"),
+					resource.TestCheckResourceAttr("dokploy_domain.test", "strip_path", "false"),
+					resource.TestCheckResourceAttr("dokploy_domain.test", "certificate_type", "custom"),
+					resource.TestCheckResourceAttr("dokploy_domain.test", "custom_cert_resolver", "tfresolver"),
+				),
+			},
+			// Plain HTTP clears the resolver
+			{
+				Config: testAccDomainResourceRoutingConfig(`https = false`),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("dokploy_domain.test", "certificate_type", "none"),
+					resource.TestCheckNoResourceAttr("dokploy_domain.test", "custom_cert_resolver"),
+				),
+			},
+		},
+	})
+}
+
+func TestAccDomainResourceValidateDNS(t *testing.T) {
+	host := os.Getenv("DOKPLOY_HOST")
+	apiKey := os.Getenv("DOKPLOY_API_KEY")
+
+	if host == "" || apiKey == "" {
+		t.Skip("DOKPLOY_HOST and DOKPLOY_API_KEY must be set for acceptance tests")
+	}
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			// A mismatch only warns
+			{
+				Config: testAccDomainResourceRoutingConfig(`validate_dns = "warn"`),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("dokploy_domain.test", "validate_dns", "warn"),
+				),
+			},
+			// routing.example.com does not point at the Dokploy host
+			{
+				Config:      testAccDomainResourceRoutingConfig(`validate_dns = "error"`),
+				PlanOnly:    true,
+				ExpectError: regexp.MustCompile(`DNS Mismatch`),
+			},
+		},
+	})
+}
+
+func TestAccDomainResourceConflict(t *testing.T) {
+	host := os.Getenv("DOKPLOY_HOST")
+	apiKey := os.Getenv("DOKPLOY_API_KEY")
+
+	if host == "" || apiKey == "" {
+		t.Skip("DOKPLOY_HOST and DOKPLOY_API_KEY must be set for acceptance tests")
+	}
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccDomainResourceRoutingConfig(`path = "/api"`),
+			},
+			// A second domain for the same host and path is rejected at plan time
+			{
+				Config: testAccDomainResourceRoutingConfig(`path = "/api"`) + `
+resource "dokploy_domain" "duplicate" {
+  application_id = dokploy_application.test.id
+  host           = "routing.example.com"
+  path           = "/api"
+}
+`,
+				PlanOnly:    true,
+				ExpectError: regexp.MustCompile(`routing.example.com with path /api is already routed by domain`),
+			},
+			// Another path on the same host is fine
+			{
+				Config: testAccDomainResourceRoutingConfig(`path = "/api"`) + `
+resource "dokploy_domain" "duplicate" {
+  application_id = dokploy_application.test.id
+  host           = "routing.example.com"
+  path           = "/web"
+}
+`,
+				Check: resource.TestCheckResourceAttr("dokploy_domain.duplicate", "path", "/web"),
+			},
+		},
+	})
+}
+
 func testAccDomainResourceConfig(projectName, envName, appName, host string, port int) string {
 	return fmt.Sprintf(`
 provider "dokploy" {
@@ -221,6 +366,30 @@ services:
 					resource.TestCheckResourceAttr("dokploy_domain.test", "port", "8080"),
 				),
 			},
+			// A service missing from the compose file is rejected at plan time
+			{
+				Config: testAccDomainResourceWithComposeConfig("test-domain-compose-project", "test-domain-compose-env", "test-domain-compose", composeContent, "updated-compose.example.com", 8080) + `
+resource "dokploy_domain" "typo" {
+  compose_id   = dokploy_compose.test.id
+  service_name = "wbe"
+  host         = "typo-compose.example.com"
+}
+`,
+				PlanOnly:    true,
+				ExpectError: regexp.MustCompile(`Service "wbe" is not defined in compose`),
+			},
+			// A service missing from the compose file is rejected at plan time
+			{
+				Config: testAccDomainResourceWithComposeConfig("test-domain-compose-project", "test-domain-compose-env", "test-domain-compose", composeContent, "updated-compose.example.com", 8080) + `
+resource "dokploy_domain" "typo" {
+  compose_id   = dokploy_compose.test.id
+  service_name = "wbe"
+  host         = "typo-compose.example.com"
+}
+`,
+				PlanOnly:    true,
+				ExpectError: regexp.MustCompile(`Service "wbe" is not defined in compose`),
+			},
 			// ImportState testing
 			{
 				ResourceName:      "dokploy_domain.test",
@@ -345,3 +514,36 @@ resource "dokploy_domain" "test" {
 }
 `, os.Getenv("DOKPLOY_HOST"), os.Getenv("DOKPLOY_API_KEY"), projectName, envName, composeName, composeContent, port)
 }
+
+func testAccDomainResourceRoutingConfig(domainAttributes string) string {
+	return fmt.Sprintf(`
+provider "dokploy" {
+  host    = "%s"
+  api_key = "%s"
+}
+
+resource "dokploy_project" "test" {
+  name        = "test-domain-routing-project"
+  description = "Test project for domain routing tests"
+}
+
+resource "dokploy_environment" "test" {
+  project_id = dokploy_project.test.id
+  name       = "test-domain-routing-env"
+}
+
+resource "dokploy_application" "test" {
+  environment_id = dokploy_environment.test.id
+  name           = "test-domain-routing-app"
+  build_type     = "nixpacks"
+  source_type    = "docker"
+  docker_image   = "nginx:latest"
+}
+
+resource "dokploy_domain" "test" {
+  application_id = dokploy_application.test.id
+  host           = "routing.example.com"
+%s
+}
+`, os.Getenv("DOKPLOY_HOST"), os.Getenv("DOKPLOY_API_KEY"), domainAttributes)
+}
@@ -16,6 +16,7 @@ import (
 
 var _ resource.Resource = &EnvironmentResource{}
 var _ resource.ResourceWithImportState = &EnvironmentResource{}
+var _ resource.ResourceWithIdentity = &EnvironmentResource{}
 
 func NewEnvironmentResource() resource.Resource {
 	return &EnvironmentResource{}
@@ -62,6 +63,10 @@ func (r *EnvironmentResource) Schema(_ context.Context, _ resource.SchemaRequest
 	}
 }
 
+func (r *EnvironmentResource) IdentitySchema(_ context.Context, _ resource.IdentitySchemaRequest, resp *resource.IdentitySchemaResponse) {
+	resp.IdentitySchema = idIdentitySchema()
+}
+
 func (r *EnvironmentResource) Configure(_ context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
 	if req.ProviderData == nil {
 		return
@@ -119,6 +124,7 @@ func (r *EnvironmentResource) Create(ctx context.Context, req resource.CreateReq
 
 	diags = resp.State.Set(ctx, plan)
 	resp.Diagnostics.Append(diags...)
+	setIdentity(ctx, resp.State, resp.Identity, &resp.Diagnostics)
 }
 
 func (r *EnvironmentResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
@@ -153,6 +159,7 @@ func (r *EnvironmentResource) Read(ctx context.Context, req resource.ReadRequest
 
 	diags = resp.State.Set(ctx, state)
 	resp.Diagnostics.Append(diags...)
+	setIdentity(ctx, resp.State, resp.Identity, &resp.Diagnostics)
 }
 
 func (r *EnvironmentResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
@@ -181,6 +188,7 @@ func (r *EnvironmentResource) Update(ctx context.Context, req resource.UpdateReq
 
 	diags = resp.State.Set(ctx, plan)
 	resp.Diagnostics.Append(diags...)
+	setIdentity(ctx, resp.State, resp.Identity, &resp.Diagnostics)
 }
 
 func (r *EnvironmentResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
@@ -199,19 +207,42 @@ func (r *EnvironmentResource) Delete(ctx context.Context, req resource.DeleteReq
 }
 
 func (r *EnvironmentResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
-	// Import format: project_id:environment_id
-	parts := strings.Split(req.ID, ":")
-	if len(parts) != 2 {
-		resp.Diagnostics.AddError(
-			"Invalid Import ID",
-			fmt.Sprintf("Expected import ID format: project_id:environment_id, got: %s", req.ID),
-		)
-		return
+	// Import format: project_id:environment_id, project/environment or environment_id
+	importID := importStateID(ctx, req, &resp.Diagnostics)
+	var projectID, environmentID string
+	switch {
+	case isImportPath(importID):
+		parts, err := splitImportPath(importID, 2, "project/environment")
+		if err != nil {
+			resp.Diagnostics.AddError("Invalid Import ID", err.Error())
+			return
+		}
+		env, err := resolveEnvironmentPath(r.client, parts[0], parts[1])
+		if err != nil {
+			resp.Diagnostics.AddError("Invalid Import ID", err.Error())
+			return
+		}
+		projectID, environmentID = env.ProjectID, env.ID
+	case strings.Contains(importID, ":"):
+		parts := strings.Split(importID, ":")
+		if len(parts) != 2 {
+			resp.Diagnostics.AddError(
+				"Invalid Import ID",
+				fmt.Sprintf("Expected import ID format: project_id:environment_id or project/environment, got: %s", importID),
+			)
+			return
+		}
+		projectID, environmentID = parts[0], parts[1]
+	default:
+		var err error
+		projectID, err = findEnvironmentProject(r.client, importID)
+		if err != nil {
+			resp.Diagnostics.AddError("Invalid Import ID", err.Error())
+			return
+		}
+		environmentID = importID
 	}
 
-	projectID := parts[0]
-	environmentID := parts[1]
-
 	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("id"), environmentID)...)
 	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("project_id"), projectID)...)
 }
@@ -55,6 +55,13 @@ func TestAccEnvironmentResource(t *testing.T) {
 					return fmt.Sprintf("%s:%s", projectID, environmentID), nil
 				},
 			},
+			// ImportState testing with a name path (project/environment)
+			{
+				ResourceName:      "dokploy_environment.test",
+				ImportState:       true,
+				ImportStateVerify: true,
+				ImportStateId:     "test-env-project/production",
+			},
 		},
 	})
 }
@@ -8,6 +8,7 @@ import (
 	"github.com/ahmedali6/terraform-provider-dokploy/internal/client"
 	"github.com/hashicorp/terraform-plugin-framework/path"
 	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/identityschema"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/booldefault"
 	"github.com/hashicorp/terraform-plugin-framework/types"
@@ -15,6 +16,7 @@ import (
 
 var _ resource.Resource = &EnvironmentVariablesResource{}
 var _ resource.ResourceWithImportState = &EnvironmentVariablesResource{}
+var _ resource.ResourceWithIdentity = &EnvironmentVariablesResource{}
 
 func NewEnvironmentVariablesResource() resource.Resource {
 	return &EnvironmentVariablesResource{}
@@ -33,6 +35,9 @@ type EnvironmentVariablesResourceModel struct {
 
 func (r *EnvironmentVariablesResource) Metadata(_ context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
 	resp.TypeName = req.ProviderTypeName + "_environment_variables"
+
+	// The application can be changed in place.
+	resp.ResourceBehavior.MutableIdentity = true
 }
 
 func (r *EnvironmentVariablesResource) Schema(_ context.Context, _ resource.SchemaRequest, resp *resource.SchemaResponse) {
@@ -59,6 +64,17 @@ func (r *EnvironmentVariablesResource) Schema(_ context.Context, _ resource.Sche
 	}
 }
 
+func (r *EnvironmentVariablesResource) IdentitySchema(_ context.Context, _ resource.IdentitySchemaRequest, resp *resource.IdentitySchemaResponse) {
+	resp.IdentitySchema = identityschema.Schema{
+		Attributes: map[string]identityschema.Attribute{
+			"application_id": identityschema.StringAttribute{
+				RequiredForImport: true,
+				Description:       "The ID of the application.",
+			},
+		},
+	}
+}
+
 func (r *EnvironmentVariablesResource) Configure(_ context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
 	if req.ProviderData == nil {
 		return
@@ -101,6 +117,7 @@ func (r *EnvironmentVariablesResource) Create(ctx context.Context, req resource.
 
 	diags = resp.State.Set(ctx, plan)
 	resp.Diagnostics.Append(diags...)
+	setIdentity(ctx, resp.State, resp.Identity, &resp.Diagnostics)
 }
 
 func (r *EnvironmentVariablesResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
@@ -130,6 +147,7 @@ func (r *EnvironmentVariablesResource) Read(ctx context.Context, req resource.Re
 
 	diags = resp.State.Set(ctx, state)
 	resp.Diagnostics.Append(diags...)
+	setIdentity(ctx, resp.State, resp.Identity, &resp.Diagnostics)
 }
 
 func (r *EnvironmentVariablesResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
@@ -168,6 +186,7 @@ func (r *EnvironmentVariablesResource) Update(ctx context.Context, req resource.
 
 	diags = resp.State.Set(ctx, plan)
 	resp.Diagnostics.Append(diags...)
+	setIdentity(ctx, resp.State, resp.Identity, &resp.Diagnostics)
 }
 
 func (r *EnvironmentVariablesResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
@@ -196,6 +215,9 @@ func (r *EnvironmentVariablesResource) Delete(ctx context.Context, req resource.
 func (r *EnvironmentVariablesResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
 	// The import ID is the application_id
 	applicationID := req.ID
+	if importStateFromIdentity(ctx, req, resp) {
+		resp.Diagnostics.Append(req.Identity.GetAttribute(ctx, path.Root("application_id"), &applicationID)...)
+	}
 
 	// Set both id and application_id to the same value
 	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("id"), applicationID)...)
@@ -15,6 +15,7 @@ import (
 
 var _ resource.Resource = &GiteaProviderResource{}
 var _ resource.ResourceWithImportState = &GiteaProviderResource{}
+var _ resource.ResourceWithIdentity = &GiteaProviderResource{}
 
 func NewGiteaProviderResource() resource.Resource {
 	return &GiteaProviderResource{}
@@ -136,6 +137,10 @@ func (r *GiteaProviderResource) Schema(_ context.Context, _ resource.SchemaReque
 	}
 }
 
+func (r *GiteaProviderResource) IdentitySchema(_ context.Context, _ resource.IdentitySchemaRequest, resp *resource.IdentitySchemaResponse) {
+	resp.IdentitySchema = idIdentitySchema()
+}
+
 func (r *GiteaProviderResource) Configure(_ context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
 	if req.ProviderData == nil {
 		return
@@ -190,6 +195,7 @@ func (r *GiteaProviderResource) Create(ctx context.Context, req resource.CreateR
 
 	diags = resp.State.Set(ctx, plan)
 	resp.Diagnostics.Append(diags...)
+	setIdentity(ctx, resp.State, resp.Identity, &resp.Diagnostics)
 }
 
 func (r *GiteaProviderResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
@@ -237,6 +243,7 @@ func (r *GiteaProviderResource) Read(ctx context.Context, req resource.ReadReque
 
 	diags = resp.State.Set(ctx, &state)
 	resp.Diagnostics.Append(diags...)
+	setIdentity(ctx, resp.State, resp.Identity, &resp.Diagnostics)
 }
 
 func (r *GiteaProviderResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
@@ -290,6 +297,7 @@ func (r *GiteaProviderResource) Update(ctx context.Context, req resource.UpdateR
 
 	diags = resp.State.Set(ctx, plan)
 	resp.Diagnostics.Append(diags...)
+	setIdentity(ctx, resp.State, resp.Identity, &resp.Diagnostics)
 }
 
 func (r *GiteaProviderResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
@@ -315,5 +323,5 @@ func (r *GiteaProviderResource) Delete(ctx context.Context, req resource.DeleteR
 }
 
 func (r *GiteaProviderResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
-	resource.ImportStatePassthroughID(ctx, path.Root("id"), req, resp)
+	resource.ImportStatePassthroughWithIdentity(ctx, path.Root("id"), path.Root("id"), req, resp)
 }
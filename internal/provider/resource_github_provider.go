@@ -0,0 +1,290 @@
+package provider
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"github.com/ahmedali6/terraform-provider-dokploy/internal/client"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/int64planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+var _ resource.Resource = &GithubProviderResource{}
+var _ resource.ResourceWithImportState = &GithubProviderResource{}
+var _ resource.ResourceWithIdentity = &GithubProviderResource{}
+
+func NewGithubProviderResource() resource.Resource {
+	return &GithubProviderResource{}
+}
+
+type GithubProviderResource struct {
+	client *client.DokployClient
+}
+
+type GithubProviderResourceModel struct {
+	ID             types.String `tfsdk:"id"`
+	GitProviderId  types.String `tfsdk:"git_provider_id"`
+	Name           types.String `tfsdk:"name"`
+	AppName        types.String `tfsdk:"app_name"`
+	AppID          types.Int64  `tfsdk:"app_id"`
+	ClientID       types.String `tfsdk:"client_id"`
+	ClientSecret   types.String `tfsdk:"client_secret"`
+	PrivateKey     types.String `tfsdk:"private_key"`
+	WebhookSecret  types.String `tfsdk:"webhook_secret"`
+	InstallationID types.String `tfsdk:"installation_id"`
+	OrganizationID types.String `tfsdk:"organization_id"`
+	CreatedAt      types.String `tfsdk:"created_at"`
+}
+
+func (r *GithubProviderResource) Metadata(_ context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_github_provider"
+}
+
+func (r *GithubProviderResource) Schema(_ context.Context, _ resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Description: "Manages a GitHub App provider integration in Dokploy. Dokploy creates GitHub Apps through GitHub's app manifest flow, which has no API equivalent, so the provider must be created in the Dokploy UI and then imported. Attributes not set in configuration keep the values read from Dokploy.",
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				Computed:    true,
+				Description: "The unique identifier of the GitHub provider (githubId).",
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"git_provider_id": schema.StringAttribute{
+				Computed:    true,
+				Description: "The git provider ID used for deletion.",
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"name": schema.StringAttribute{
+				Required:    true,
+				Description: "The name of the GitHub provider.",
+			},
+			"app_name": schema.StringAttribute{
+				Optional:    true,
+				Computed:    true,
+				Description: "The GitHub App name (slug).",
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"app_id": schema.Int64Attribute{
+				Optional:    true,
+				Computed:    true,
+				Description: "The GitHub App ID.",
+				PlanModifiers: []planmodifier.Int64{
+					int64planmodifier.UseStateForUnknown(),
+				},
+			},
+			"client_id": schema.StringAttribute{
+				Optional:    true,
+				Computed:    true,
+				Description: "The GitHub App client ID.",
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"client_secret": schema.StringAttribute{
+				Optional:    true,
+				Computed:    true,
+				Sensitive:   true,
+				Description: "The GitHub App client secret.",
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"private_key": schema.StringAttribute{
+				Optional:    true,
+				Computed:    true,
+				Sensitive:   true,
+				Description: "The GitHub App private key in PEM format.",
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"webhook_secret": schema.StringAttribute{
+				Optional:    true,
+				Computed:    true,
+				Sensitive:   true,
+				Description: "The GitHub App webhook secret.",
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"installation_id": schema.StringAttribute{
+				Optional:    true,
+				Computed:    true,
+				Description: "The installation ID of the GitHub App on the account or organization.",
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"organization_id": schema.StringAttribute{
+				Computed:    true,
+				Description: "The Dokploy organization ID.",
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"created_at": schema.StringAttribute{
+				Computed:    true,
+				Description: "The creation timestamp.",
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+		},
+	}
+}
+
+func (r *GithubProviderResource) IdentitySchema(_ context.Context, _ resource.IdentitySchemaRequest, resp *resource.IdentitySchemaResponse) {
+	resp.IdentitySchema = idIdentitySchema()
+}
+
+func (r *GithubProviderResource) Configure(_ context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+	client, ok := req.ProviderData.(*client.DokployClient)
+	if !ok {
+		resp.Diagnostics.AddError("Unexpected Resource Configure Type", fmt.Sprintf("Expected *client.DokployClient, got: %T", req.ProviderData))
+		return
+	}
+	r.client = client
+}
+
+func githubProviderFromPlan(plan *GithubProviderResourceModel) client.GithubAppProvider {
+	return client.GithubAppProvider{
+		Name:                 plan.Name.ValueString(),
+		GithubAppName:        plan.AppName.ValueString(),
+		GithubAppId:          plan.AppID.ValueInt64(),
+		GithubClientId:       plan.ClientID.ValueString(),
+		GithubClientSecret:   plan.ClientSecret.ValueString(),
+		GithubPrivateKey:     plan.PrivateKey.ValueString(),
+		GithubWebhookSecret:  plan.WebhookSecret.ValueString(),
+		GithubInstallationId: plan.InstallationID.ValueString(),
+	}
+}
+
+// Create fails: Dokploy has no endpoint to create a GitHub provider. The
+// GitHub App is registered through GitHub's manifest flow in the Dokploy UI.
+func (r *GithubProviderResource) Create(_ context.Context, _ resource.CreateRequest, resp *resource.CreateResponse) {
+	resp.Diagnostics.AddError(
+		"GitHub Provider Must Be Imported",
+		"Dokploy cannot create GitHub providers through its API. Create the GitHub App in the Dokploy UI (Settings > Git > GitHub), "+
+			"then import it with an import block or `terraform import dokploy_github_provider.<name> <githubId>`. "+
+			"The githubId is listed by the dokploy_github_providers data source.",
+	)
+}
+
+func (r *GithubProviderResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	var state GithubProviderResourceModel
+	diags := req.State.Get(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	provider, err := r.client.GetGithubProvider(state.ID.ValueString())
+	if err != nil {
+		if errors.Is(err, client.ErrNotFound) {
+			resp.State.RemoveResource(ctx)
+			return
+		}
+		resp.Diagnostics.AddError("Error reading GitHub provider", err.Error())
+		return
+	}
+
+	state.ID = types.StringValue(provider.ID)
+	state.GitProviderId = types.StringValue(provider.GitProviderId)
+	state.Name = types.StringValue(provider.Name)
+	state.AppID = types.Int64Value(provider.GithubAppId)
+	state.ClientID = types.StringValue(provider.GithubClientId)
+	state.OrganizationID = types.StringValue(provider.OrganizationID)
+	state.CreatedAt = types.StringValue(provider.CreatedAt)
+
+	if provider.GithubAppName != "" {
+		state.AppName = types.StringValue(provider.GithubAppName)
+	}
+	if provider.GithubInstallationId != "" {
+		state.InstallationID = types.StringValue(provider.GithubInstallationId)
+	}
+	// Secrets are refreshed so that a secret rotated in the Dokploy UI shows
+	// up as drift.
+	state.ClientSecret = optionalString(provider.GithubClientSecret)
+	state.PrivateKey = optionalString(provider.GithubPrivateKey)
+	state.WebhookSecret = optionalString(provider.GithubWebhookSecret)
+
+	diags = resp.State.Set(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+	setIdentity(ctx, resp.State, resp.Identity, &resp.Diagnostics)
+}
+
+func (r *GithubProviderResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	var plan GithubProviderResourceModel
+	diags := req.Plan.Get(ctx, &plan)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	var state GithubProviderResourceModel
+	diags = req.State.Get(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	provider := githubProviderFromPlan(&plan)
+	provider.ID = state.ID.ValueString()
+	provider.GitProviderId = state.GitProviderId.ValueString()
+
+	updated, err := r.client.UpdateGithubProvider(provider)
+	if err != nil {
+		resp.Diagnostics.AddError("Error updating GitHub provider", err.Error())
+		return
+	}
+
+	plan.ID = types.StringValue(updated.ID)
+	plan.GitProviderId = types.StringValue(updated.GitProviderId)
+	plan.OrganizationID = types.StringValue(updated.OrganizationID)
+	plan.CreatedAt = types.StringValue(updated.CreatedAt)
+
+	diags = resp.State.Set(ctx, plan)
+	resp.Diagnostics.Append(diags...)
+	setIdentity(ctx, resp.State, resp.Identity, &resp.Diagnostics)
+}
+
+func (r *GithubProviderResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	var state GithubProviderResourceModel
+	diags := req.State.Get(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Use gitProviderId for deletion
+	gitProviderId := state.GitProviderId.ValueString()
+	if gitProviderId == "" {
+		resp.Diagnostics.AddError("Error deleting GitHub provider", "gitProviderId is not set")
+		return
+	}
+
+	err := r.client.DeleteGitProvider(gitProviderId)
+	if err != nil && !errors.Is(err, client.ErrNotFound) {
+		resp.Diagnostics.AddError("Error deleting GitHub provider", err.Error())
+		return
+	}
+}
+
+func (r *GithubProviderResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	resource.ImportStatePassthroughWithIdentity(ctx, path.Root("id"), path.Root("id"), req, resp)
+}
@@ -15,6 +15,7 @@ import (
 
 var _ resource.Resource = &GitlabProviderResource{}
 var _ resource.ResourceWithImportState = &GitlabProviderResource{}
+var _ resource.ResourceWithIdentity = &GitlabProviderResource{}
 
 func NewGitlabProviderResource() resource.Resource {
 	return &GitlabProviderResource{}
@@ -125,6 +126,10 @@ func (r *GitlabProviderResource) Schema(_ context.Context, _ resource.SchemaRequ
 	}
 }
 
+func (r *GitlabProviderResource) IdentitySchema(_ context.Context, _ resource.IdentitySchemaRequest, resp *resource.IdentitySchemaResponse) {
+	resp.IdentitySchema = idIdentitySchema()
+}
+
 func (r *GitlabProviderResource) Configure(_ context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
 	if req.ProviderData == nil {
 		return
@@ -174,6 +179,7 @@ func (r *GitlabProviderResource) Create(ctx context.Context, req resource.Create
 
 	diags = resp.State.Set(ctx, plan)
 	resp.Diagnostics.Append(diags...)
+	setIdentity(ctx, resp.State, resp.Identity, &resp.Diagnostics)
 }
 
 func (r *GitlabProviderResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
@@ -215,6 +221,7 @@ func (r *GitlabProviderResource) Read(ctx context.Context, req resource.ReadRequ
 
 	diags = resp.State.Set(ctx, &state)
 	resp.Diagnostics.Append(diags...)
+	setIdentity(ctx, resp.State, resp.Identity, &resp.Diagnostics)
 }
 
 func (r *GitlabProviderResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
@@ -263,6 +270,7 @@ func (r *GitlabProviderResource) Update(ctx context.Context, req resource.Update
 
 	diags = resp.State.Set(ctx, plan)
 	resp.Diagnostics.Append(diags...)
+	setIdentity(ctx, resp.State, resp.Identity, &resp.Diagnostics)
 }
 
 func (r *GitlabProviderResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
@@ -288,5 +296,5 @@ func (r *GitlabProviderResource) Delete(ctx context.Context, req resource.Delete
 }
 
 func (r *GitlabProviderResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
-	resource.ImportStatePassthroughID(ctx, path.Root("id"), req, resp)
+	resource.ImportStatePassthroughWithIdentity(ctx, path.Root("id"), path.Root("id"), req, resp)
 }
@@ -44,7 +44,7 @@ func TestAccMariaDBResource(t *testing.T) {
 				ResourceName:            "dokploy_mariadb.test",
 				ImportState:             true,
 				ImportStateVerify:       true,
-				ImportStateVerifyIgnore: []string{"database_password", "database_root_password", "app_name"},
+				ImportStateVerifyIgnore: []string{"database_password", "internal_connection_url", "external_connection_url", "database_root_password", "app_name"},
 			},
 		},
 	})
@@ -44,7 +44,7 @@ func TestAccMongoDBResource(t *testing.T) {
 				ResourceName:            "dokploy_mongo.test",
 				ImportState:             true,
 				ImportStateVerify:       true,
-				ImportStateVerifyIgnore: []string{"database_password", "app_name"},
+				ImportStateVerifyIgnore: []string{"database_password", "internal_connection_url", "external_connection_url", "app_name"},
 			},
 		},
 	})
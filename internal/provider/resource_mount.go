@@ -7,6 +7,7 @@ import (
 	"github.com/ahmedali6/terraform-provider-dokploy/internal/client"
 	"github.com/hashicorp/terraform-plugin-framework/path"
 	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/identityschema"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringdefault"
@@ -16,6 +17,7 @@ import (
 
 var _ resource.Resource = &MountResource{}
 var _ resource.ResourceWithImportState = &MountResource{}
+var _ resource.ResourceWithIdentity = &MountResource{}
 
 func NewMountResource() resource.Resource {
 	return &MountResource{}
@@ -39,6 +41,9 @@ type MountResourceModel struct {
 
 func (r *MountResource) Metadata(_ context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
 	resp.TypeName = req.ProviderTypeName + "_mount"
+
+	// The mount path and service type can be updated in place.
+	resp.ResourceBehavior.MutableIdentity = true
 }
 
 func (r *MountResource) Schema(_ context.Context, _ resource.SchemaRequest, resp *resource.SchemaResponse) {
@@ -94,6 +99,25 @@ func (r *MountResource) Schema(_ context.Context, _ resource.SchemaRequest, resp
 	}
 }
 
+func (r *MountResource) IdentitySchema(_ context.Context, _ resource.IdentitySchemaRequest, resp *resource.IdentitySchemaResponse) {
+	resp.IdentitySchema = identityschema.Schema{
+		Attributes: map[string]identityschema.Attribute{
+			"service_type": identityschema.StringAttribute{
+				RequiredForImport: true,
+				Description:       "Type of service: application, postgres, mysql, mariadb, mongo, redis, compose.",
+			},
+			"service_id": identityschema.StringAttribute{
+				RequiredForImport: true,
+				Description:       "The ID of the service the mount belongs to.",
+			},
+			"mount_path": identityschema.StringAttribute{
+				RequiredForImport: true,
+				Description:       "Path where the mount is mounted inside the container.",
+			},
+		},
+	}
+}
+
 func (r *MountResource) Configure(_ context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
 	if req.ProviderData == nil {
 		return
@@ -138,6 +162,7 @@ func (r *MountResource) Create(ctx context.Context, req resource.CreateRequest,
 
 	diags = resp.State.Set(ctx, plan)
 	resp.Diagnostics.Append(diags...)
+	setIdentity(ctx, resp.State, resp.Identity, &resp.Diagnostics)
 }
 
 func (r *MountResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
@@ -200,6 +225,7 @@ func (r *MountResource) Read(ctx context.Context, req resource.ReadRequest, resp
 
 	diags = resp.State.Set(ctx, &state)
 	resp.Diagnostics.Append(diags...)
+	setIdentity(ctx, resp.State, resp.Identity, &resp.Diagnostics)
 }
 
 func (r *MountResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
@@ -280,6 +306,7 @@ func (r *MountResource) Update(ctx context.Context, req resource.UpdateRequest,
 
 	diags = resp.State.Set(ctx, plan)
 	resp.Diagnostics.Append(diags...)
+	setIdentity(ctx, resp.State, resp.Identity, &resp.Diagnostics)
 }
 
 func (r *MountResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
@@ -297,6 +324,35 @@ func (r *MountResource) Delete(ctx context.Context, req resource.DeleteRequest,
 	}
 }
 
+// ImportState accepts the mount ID, or an identity of the service and mount
+// path.
 func (r *MountResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
-	resource.ImportStatePassthroughID(ctx, path.Root("id"), req, resp)
+	if req.ID != "" || req.Identity == nil {
+		resource.ImportStatePassthroughID(ctx, path.Root("id"), req, resp)
+		return
+	}
+
+	var serviceType, serviceID, mountPath string
+	resp.Diagnostics.Append(req.Identity.GetAttribute(ctx, path.Root("service_type"), &serviceType)...)
+	resp.Diagnostics.Append(req.Identity.GetAttribute(ctx, path.Root("service_id"), &serviceID)...)
+	resp.Diagnostics.Append(req.Identity.GetAttribute(ctx, path.Root("mount_path"), &mountPath)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	mounts, err := r.client.GetMountsByService(serviceID, serviceType)
+	if err != nil {
+		resp.Diagnostics.AddError("Error reading mounts", err.Error())
+		return
+	}
+	for _, mount := range mounts {
+		if mount.MountPath == mountPath {
+			resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("id"), mount.ID)...)
+			return
+		}
+	}
+	resp.Diagnostics.AddError(
+		"Mount Not Found",
+		fmt.Sprintf("The %s %s has no mount at %s.", serviceType, serviceID, mountPath),
+	)
 }
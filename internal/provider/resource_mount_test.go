@@ -6,6 +6,8 @@ import (
 	"testing"
 
 	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/statecheck"
+	"github.com/hashicorp/terraform-plugin-testing/tfjsonpath"
 )
 
 func TestAccMountResource(t *testing.T) {
@@ -30,6 +32,11 @@ func TestAccMountResource(t *testing.T) {
 					resource.TestCheckResourceAttrSet("dokploy_mount.test", "id"),
 					resource.TestCheckResourceAttrSet("dokploy_mount.test", "service_id"),
 				),
+				ConfigStateChecks: []statecheck.StateCheck{
+					statecheck.ExpectIdentityValueMatchesState("dokploy_mount.test", tfjsonpath.New("service_type")),
+					statecheck.ExpectIdentityValueMatchesState("dokploy_mount.test", tfjsonpath.New("service_id")),
+					statecheck.ExpectIdentityValueMatchesState("dokploy_mount.test", tfjsonpath.New("mount_path")),
+				},
 			},
 			// Update testing - change volume name and mount path
 			{
@@ -46,6 +53,12 @@ func TestAccMountResource(t *testing.T) {
 				ImportState:       true,
 				ImportStateVerify: true,
 			},
+			// ImportState testing with an import block and resource identity
+			{
+				ResourceName:    "dokploy_mount.test",
+				ImportState:     true,
+				ImportStateKind: resource.ImportBlockWithResourceIdentity,
+			},
 		},
 	})
 }
@@ -5,7 +5,11 @@ import (
 	"os"
 	"testing"
 
+	"github.com/hashicorp/terraform-plugin-testing/compare"
 	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/plancheck"
+	"github.com/hashicorp/terraform-plugin-testing/statecheck"
+	"github.com/hashicorp/terraform-plugin-testing/tfjsonpath"
 )
 
 func TestAccMySQLResource(t *testing.T) {
@@ -44,7 +48,7 @@ func TestAccMySQLResource(t *testing.T) {
 				ResourceName:            "dokploy_mysql.test",
 				ImportState:             true,
 				ImportStateVerify:       true,
-				ImportStateVerifyIgnore: []string{"database_password", "database_root_password", "app_name"},
+				ImportStateVerifyIgnore: []string{"database_password", "internal_connection_url", "external_connection_url", "database_root_password", "app_name"},
 			},
 		},
 	})
@@ -108,3 +112,85 @@ resource "dokploy_mysql" "test" {
 }
 `, os.Getenv("DOKPLOY_HOST"), os.Getenv("DOKPLOY_API_KEY"), projectName, envName, mysqlName, appName, dbName, dbUser, description)
 }
+
+// TestAccMySQLResourceGeneratedPasswords tests provider-generated passwords and
+// their rotation through rotate_password_trigger.
+func TestAccMySQLResourceGeneratedPasswords(t *testing.T) {
+	host := os.Getenv("DOKPLOY_HOST")
+	apiKey := os.Getenv("DOKPLOY_API_KEY")
+
+	if host == "" || apiKey == "" {
+		t.Skip("DOKPLOY_HOST and DOKPLOY_API_KEY must be set for acceptance tests")
+	}
+
+	passwordsDiffer := statecheck.CompareValue(compare.ValuesDiffer())
+	rootPasswordsDiffer := statecheck.CompareValue(compare.ValuesDiffer())
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccMySQLResourceConfigGeneratedPasswords("v1"),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttrSet("dokploy_mysql.test", "database_password"),
+					resource.TestCheckResourceAttrSet("dokploy_mysql.test", "database_root_password"),
+				),
+				ConfigStateChecks: []statecheck.StateCheck{
+					passwordsDiffer.AddStateValue("dokploy_mysql.test", tfjsonpath.New("database_password")),
+					rootPasswordsDiffer.AddStateValue("dokploy_mysql.test", tfjsonpath.New("database_root_password")),
+				},
+			},
+			// Generated passwords are stable across plans
+			{
+				Config: testAccMySQLResourceConfigGeneratedPasswords("v1"),
+				ConfigPlanChecks: resource.ConfigPlanChecks{
+					PreApply: []plancheck.PlanCheck{
+						plancheck.ExpectEmptyPlan(),
+					},
+				},
+			},
+			// Changing the trigger rotates both passwords
+			{
+				Config: testAccMySQLResourceConfigGeneratedPasswords("v2"),
+				ConfigPlanChecks: resource.ConfigPlanChecks{
+					PreApply: []plancheck.PlanCheck{
+						plancheck.ExpectResourceAction("dokploy_mysql.test", plancheck.ResourceActionUpdate),
+					},
+				},
+				ConfigStateChecks: []statecheck.StateCheck{
+					passwordsDiffer.AddStateValue("dokploy_mysql.test", tfjsonpath.New("database_password")),
+					rootPasswordsDiffer.AddStateValue("dokploy_mysql.test", tfjsonpath.New("database_root_password")),
+				},
+			},
+		},
+	})
+}
+
+func testAccMySQLResourceConfigGeneratedPasswords(trigger string) string {
+	return fmt.Sprintf(`
+provider "dokploy" {
+  host    = "%s"
+  api_key = "%s"
+}
+
+resource "dokploy_project" "test" {
+  name        = "test-mysql-generated-project"
+  description = "Test project for MySQL generated password tests"
+}
+
+resource "dokploy_environment" "test" {
+  project_id = dokploy_project.test.id
+  name       = "test-mysql-generated-env"
+}
+
+resource "dokploy_mysql" "test" {
+  name                    = "test-mysql-generated"
+  app_name                = "testmysqlgen"
+  database_name           = "testdb"
+  database_user           = "testuser"
+  rotate_password_trigger = "%s"
+  environment_id          = dokploy_environment.test.id
+}
+`, os.Getenv("DOKPLOY_HOST"), os.Getenv("DOKPLOY_API_KEY"), trigger)
+}
@@ -0,0 +1,195 @@
+package provider
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"github.com/ahmedali6/terraform-provider-dokploy/internal/client"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/booldefault"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+var _ resource.Resource = &DiscordNotificationResource{}
+var _ resource.ResourceWithImportState = &DiscordNotificationResource{}
+var _ resource.ResourceWithIdentity = &DiscordNotificationResource{}
+
+func NewDiscordNotificationResource() resource.Resource {
+	return &DiscordNotificationResource{}
+}
+
+type DiscordNotificationResource struct {
+	client *client.DokployClient
+}
+
+type DiscordNotificationResourceModel struct {
+	NotificationResourceModel
+	WebhookURL types.String `tfsdk:"webhook_url"`
+	Decoration types.Bool   `tfsdk:"decoration"`
+}
+
+func (r *DiscordNotificationResource) Metadata(_ context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_notification_discord"
+}
+
+func (r *DiscordNotificationResource) Schema(_ context.Context, _ resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Description: "Manages a Discord notification channel in Dokploy.",
+		Attributes: map[string]schema.Attribute{
+			"webhook_url": schema.StringAttribute{
+				Required:    true,
+				Sensitive:   true,
+				Description: "The Discord webhook URL.",
+			},
+			"decoration": schema.BoolAttribute{
+				Optional:    true,
+				Computed:    true,
+				Default:     booldefault.StaticBool(true),
+				Description: "Whether to decorate messages with emojis. Default: true.",
+			},
+		},
+	}
+	for name, attr := range notificationSchemaAttributes() {
+		resp.Schema.Attributes[name] = attr
+	}
+}
+
+func (r *DiscordNotificationResource) IdentitySchema(_ context.Context, _ resource.IdentitySchemaRequest, resp *resource.IdentitySchemaResponse) {
+	resp.IdentitySchema = idIdentitySchema()
+}
+
+func (r *DiscordNotificationResource) Configure(_ context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+	client, ok := req.ProviderData.(*client.DokployClient)
+	if !ok {
+		resp.Diagnostics.AddError("Unexpected Resource Configure Type", fmt.Sprintf("Expected *client.DokployClient, got: %T", req.ProviderData))
+		return
+	}
+	r.client = client
+}
+
+func (m *DiscordNotificationResourceModel) payload() map[string]interface{} {
+	payload := m.NotificationResourceModel.payload()
+	payload["webhookUrl"] = m.WebhookURL.ValueString()
+	payload["decoration"] = m.Decoration.ValueBool()
+	return payload
+}
+
+func (m *DiscordNotificationResourceModel) mapFromAPI(n *client.Notification) error {
+	if err := checkNotificationType(n, "discord"); err != nil {
+		return err
+	}
+	if n.Discord == nil {
+		return fmt.Errorf("notification %s has no discord configuration", n.NotificationID)
+	}
+
+	m.NotificationResourceModel.mapFromAPI(n)
+	m.WebhookURL = types.StringValue(n.Discord.WebhookURL)
+	m.Decoration = types.BoolValue(n.Discord.Decoration)
+	return nil
+}
+
+func (r *DiscordNotificationResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	var plan DiscordNotificationResourceModel
+	diags := req.Plan.Get(ctx, &plan)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	created, err := r.client.CreateNotification("discord", plan.payload())
+	if err != nil {
+		resp.Diagnostics.AddError("Error creating Discord notification", err.Error())
+		return
+	}
+
+	plan.ID = types.StringValue(created.NotificationID)
+	plan.OrganizationID = types.StringValue(created.OrganizationID)
+	plan.CreatedAt = types.StringValue(created.CreatedAt)
+
+	diags = resp.State.Set(ctx, plan)
+	resp.Diagnostics.Append(diags...)
+	setIdentity(ctx, resp.State, resp.Identity, &resp.Diagnostics)
+}
+
+func (r *DiscordNotificationResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	var state DiscordNotificationResourceModel
+	diags := req.State.Get(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	notification, err := r.client.GetNotification(state.ID.ValueString())
+	if err != nil {
+		if errors.Is(err, client.ErrNotFound) {
+			resp.State.RemoveResource(ctx)
+			return
+		}
+		resp.Diagnostics.AddError("Error reading Discord notification", err.Error())
+		return
+	}
+
+	if err := state.mapFromAPI(notification); err != nil {
+		resp.Diagnostics.AddError("Error reading Discord notification", err.Error())
+		return
+	}
+
+	diags = resp.State.Set(ctx, state)
+	resp.Diagnostics.Append(diags...)
+	setIdentity(ctx, resp.State, resp.Identity, &resp.Diagnostics)
+}
+
+func (r *DiscordNotificationResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	var plan DiscordNotificationResourceModel
+	diags := req.Plan.Get(ctx, &plan)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	var state DiscordNotificationResourceModel
+	diags = req.State.Get(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	updated, err := r.client.UpdateNotification(state.ID.ValueString(), plan.payload())
+	if err != nil {
+		resp.Diagnostics.AddError("Error updating Discord notification", err.Error())
+		return
+	}
+
+	plan.ID = state.ID
+	plan.OrganizationID = types.StringValue(updated.OrganizationID)
+	plan.CreatedAt = state.CreatedAt
+
+	diags = resp.State.Set(ctx, plan)
+	resp.Diagnostics.Append(diags...)
+	setIdentity(ctx, resp.State, resp.Identity, &resp.Diagnostics)
+}
+
+func (r *DiscordNotificationResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	var state DiscordNotificationResourceModel
+	diags := req.State.Get(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	err := r.client.DeleteNotification(state.ID.ValueString())
+	if err != nil && !errors.Is(err, client.ErrNotFound) {
+		resp.Diagnostics.AddError("Error deleting Discord notification", err.Error())
+		return
+	}
+}
+
+func (r *DiscordNotificationResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	resource.ImportStatePassthroughWithIdentity(ctx, path.Root("id"), path.Root("id"), req, resp)
+}
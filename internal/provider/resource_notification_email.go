@@ -0,0 +1,237 @@
+package provider
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"github.com/ahmedali6/terraform-provider-dokploy/internal/client"
+	"github.com/hashicorp/terraform-plugin-framework-validators/int64validator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/listvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+var _ resource.Resource = &EmailNotificationResource{}
+var _ resource.ResourceWithImportState = &EmailNotificationResource{}
+var _ resource.ResourceWithIdentity = &EmailNotificationResource{}
+
+func NewEmailNotificationResource() resource.Resource {
+	return &EmailNotificationResource{}
+}
+
+type EmailNotificationResource struct {
+	client *client.DokployClient
+}
+
+type EmailNotificationResourceModel struct {
+	NotificationResourceModel
+	SMTPServer  types.String   `tfsdk:"smtp_server"`
+	SMTPPort    types.Int64    `tfsdk:"smtp_port"`
+	Username    types.String   `tfsdk:"username"`
+	Password    types.String   `tfsdk:"password"`
+	FromAddress types.String   `tfsdk:"from_address"`
+	ToAddresses []types.String `tfsdk:"to_addresses"`
+}
+
+func (r *EmailNotificationResource) Metadata(_ context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_notification_email"
+}
+
+func (r *EmailNotificationResource) Schema(_ context.Context, _ resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Description: "Manages an email (SMTP) notification channel in Dokploy.",
+		Attributes: map[string]schema.Attribute{
+			"smtp_server": schema.StringAttribute{
+				Required:    true,
+				Description: "The SMTP server hostname.",
+			},
+			"smtp_port": schema.Int64Attribute{
+				Required:    true,
+				Description: "The SMTP server port.",
+				Validators: []validator.Int64{
+					int64validator.Between(1, 65535),
+				},
+			},
+			"username": schema.StringAttribute{
+				Required:    true,
+				Description: "The SMTP username.",
+			},
+			"password": schema.StringAttribute{
+				Required:    true,
+				Sensitive:   true,
+				Description: "The SMTP password.",
+			},
+			"from_address": schema.StringAttribute{
+				Required:    true,
+				Description: "The sender email address.",
+			},
+			"to_addresses": schema.ListAttribute{
+				Required:    true,
+				ElementType: types.StringType,
+				Description: "The recipient email addresses.",
+				Validators: []validator.List{
+					listvalidator.SizeAtLeast(1),
+				},
+			},
+		},
+	}
+	for name, attr := range notificationSchemaAttributes() {
+		resp.Schema.Attributes[name] = attr
+	}
+}
+
+func (r *EmailNotificationResource) IdentitySchema(_ context.Context, _ resource.IdentitySchemaRequest, resp *resource.IdentitySchemaResponse) {
+	resp.IdentitySchema = idIdentitySchema()
+}
+
+func (r *EmailNotificationResource) Configure(_ context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+	client, ok := req.ProviderData.(*client.DokployClient)
+	if !ok {
+		resp.Diagnostics.AddError("Unexpected Resource Configure Type", fmt.Sprintf("Expected *client.DokployClient, got: %T", req.ProviderData))
+		return
+	}
+	r.client = client
+}
+
+func (m *EmailNotificationResourceModel) payload() map[string]interface{} {
+	payload := m.NotificationResourceModel.payload()
+	toAddresses := make([]string, len(m.ToAddresses))
+	for i, address := range m.ToAddresses {
+		toAddresses[i] = address.ValueString()
+	}
+	payload["smtpServer"] = m.SMTPServer.ValueString()
+	payload["smtpPort"] = m.SMTPPort.ValueInt64()
+	payload["username"] = m.Username.ValueString()
+	payload["password"] = m.Password.ValueString()
+	payload["fromAddress"] = m.FromAddress.ValueString()
+	payload["toAddresses"] = toAddresses
+	return payload
+}
+
+func (m *EmailNotificationResourceModel) mapFromAPI(n *client.Notification) error {
+	if err := checkNotificationType(n, "email"); err != nil {
+		return err
+	}
+	if n.Email == nil {
+		return fmt.Errorf("notification %s has no email configuration", n.NotificationID)
+	}
+
+	m.NotificationResourceModel.mapFromAPI(n)
+	m.SMTPServer = types.StringValue(n.Email.SMTPServer)
+	m.SMTPPort = types.Int64Value(n.Email.SMTPPort)
+	m.Username = types.StringValue(n.Email.Username)
+	m.Password = types.StringValue(n.Email.Password)
+	m.FromAddress = types.StringValue(n.Email.FromAddress)
+	m.ToAddresses = make([]types.String, len(n.Email.ToAddresses))
+	for i, address := range n.Email.ToAddresses {
+		m.ToAddresses[i] = types.StringValue(address)
+	}
+	return nil
+}
+
+func (r *EmailNotificationResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	var plan EmailNotificationResourceModel
+	diags := req.Plan.Get(ctx, &plan)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	created, err := r.client.CreateNotification("email", plan.payload())
+	if err != nil {
+		resp.Diagnostics.AddError("Error creating email notification", err.Error())
+		return
+	}
+
+	plan.ID = types.StringValue(created.NotificationID)
+	plan.OrganizationID = types.StringValue(created.OrganizationID)
+	plan.CreatedAt = types.StringValue(created.CreatedAt)
+
+	diags = resp.State.Set(ctx, plan)
+	resp.Diagnostics.Append(diags...)
+	setIdentity(ctx, resp.State, resp.Identity, &resp.Diagnostics)
+}
+
+func (r *EmailNotificationResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	var state EmailNotificationResourceModel
+	diags := req.State.Get(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	notification, err := r.client.GetNotification(state.ID.ValueString())
+	if err != nil {
+		if errors.Is(err, client.ErrNotFound) {
+			resp.State.RemoveResource(ctx)
+			return
+		}
+		resp.Diagnostics.AddError("Error reading email notification", err.Error())
+		return
+	}
+
+	if err := state.mapFromAPI(notification); err != nil {
+		resp.Diagnostics.AddError("Error reading email notification", err.Error())
+		return
+	}
+
+	diags = resp.State.Set(ctx, state)
+	resp.Diagnostics.Append(diags...)
+	setIdentity(ctx, resp.State, resp.Identity, &resp.Diagnostics)
+}
+
+func (r *EmailNotificationResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	var plan EmailNotificationResourceModel
+	diags := req.Plan.Get(ctx, &plan)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	var state EmailNotificationResourceModel
+	diags = req.State.Get(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	updated, err := r.client.UpdateNotification(state.ID.ValueString(), plan.payload())
+	if err != nil {
+		resp.Diagnostics.AddError("Error updating email notification", err.Error())
+		return
+	}
+
+	plan.ID = state.ID
+	plan.OrganizationID = types.StringValue(updated.OrganizationID)
+	plan.CreatedAt = state.CreatedAt
+
+	diags = resp.State.Set(ctx, plan)
+	resp.Diagnostics.Append(diags...)
+	setIdentity(ctx, resp.State, resp.Identity, &resp.Diagnostics)
+}
+
+func (r *EmailNotificationResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	var state EmailNotificationResourceModel
+	diags := req.State.Get(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	err := r.client.DeleteNotification(state.ID.ValueString())
+	if err != nil && !errors.Is(err, client.ErrNotFound) {
+		resp.Diagnostics.AddError("Error deleting email notification", err.Error())
+		return
+	}
+}
+
+func (r *EmailNotificationResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	resource.ImportStatePassthroughWithIdentity(ctx, path.Root("id"), path.Root("id"), req, resp)
+}
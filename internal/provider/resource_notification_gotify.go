@@ -0,0 +1,217 @@
+package provider
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"github.com/ahmedali6/terraform-provider-dokploy/internal/client"
+	"github.com/hashicorp/terraform-plugin-framework-validators/int64validator"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/booldefault"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/int64default"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+var _ resource.Resource = &GotifyNotificationResource{}
+var _ resource.ResourceWithImportState = &GotifyNotificationResource{}
+var _ resource.ResourceWithIdentity = &GotifyNotificationResource{}
+
+func NewGotifyNotificationResource() resource.Resource {
+	return &GotifyNotificationResource{}
+}
+
+type GotifyNotificationResource struct {
+	client *client.DokployClient
+}
+
+type GotifyNotificationResourceModel struct {
+	NotificationResourceModel
+	ServerURL  types.String `tfsdk:"server_url"`
+	AppToken   types.String `tfsdk:"app_token"`
+	Priority   types.Int64  `tfsdk:"priority"`
+	Decoration types.Bool   `tfsdk:"decoration"`
+}
+
+func (r *GotifyNotificationResource) Metadata(_ context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_notification_gotify"
+}
+
+func (r *GotifyNotificationResource) Schema(_ context.Context, _ resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Description: "Manages a Gotify notification channel in Dokploy.",
+		Attributes: map[string]schema.Attribute{
+			"server_url": schema.StringAttribute{
+				Required:    true,
+				Description: "The Gotify server URL.",
+			},
+			"app_token": schema.StringAttribute{
+				Required:    true,
+				Sensitive:   true,
+				Description: "The Gotify application token.",
+			},
+			"priority": schema.Int64Attribute{
+				Optional:    true,
+				Computed:    true,
+				Default:     int64default.StaticInt64(5),
+				Description: "The message priority (0-10). Default: 5.",
+				Validators: []validator.Int64{
+					int64validator.Between(0, 10),
+				},
+			},
+			"decoration": schema.BoolAttribute{
+				Optional:    true,
+				Computed:    true,
+				Default:     booldefault.StaticBool(true),
+				Description: "Whether to decorate messages with emojis. Default: true.",
+			},
+		},
+	}
+	for name, attr := range notificationSchemaAttributes() {
+		resp.Schema.Attributes[name] = attr
+	}
+}
+
+func (r *GotifyNotificationResource) IdentitySchema(_ context.Context, _ resource.IdentitySchemaRequest, resp *resource.IdentitySchemaResponse) {
+	resp.IdentitySchema = idIdentitySchema()
+}
+
+func (r *GotifyNotificationResource) Configure(_ context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+	client, ok := req.ProviderData.(*client.DokployClient)
+	if !ok {
+		resp.Diagnostics.AddError("Unexpected Resource Configure Type", fmt.Sprintf("Expected *client.DokployClient, got: %T", req.ProviderData))
+		return
+	}
+	r.client = client
+}
+
+func (m *GotifyNotificationResourceModel) payload() map[string]interface{} {
+	payload := m.NotificationResourceModel.payload()
+	payload["serverUrl"] = m.ServerURL.ValueString()
+	payload["appToken"] = m.AppToken.ValueString()
+	payload["priority"] = m.Priority.ValueInt64()
+	payload["decoration"] = m.Decoration.ValueBool()
+	return payload
+}
+
+func (m *GotifyNotificationResourceModel) mapFromAPI(n *client.Notification) error {
+	if err := checkNotificationType(n, "gotify"); err != nil {
+		return err
+	}
+	if n.Gotify == nil {
+		return fmt.Errorf("notification %s has no gotify configuration", n.NotificationID)
+	}
+
+	m.NotificationResourceModel.mapFromAPI(n)
+	m.ServerURL = types.StringValue(n.Gotify.ServerURL)
+	m.AppToken = types.StringValue(n.Gotify.AppToken)
+	m.Priority = types.Int64Value(n.Gotify.Priority)
+	m.Decoration = types.BoolValue(n.Gotify.Decoration)
+	return nil
+}
+
+func (r *GotifyNotificationResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	var plan GotifyNotificationResourceModel
+	diags := req.Plan.Get(ctx, &plan)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	created, err := r.client.CreateNotification("gotify", plan.payload())
+	if err != nil {
+		resp.Diagnostics.AddError("Error creating Gotify notification", err.Error())
+		return
+	}
+
+	plan.ID = types.StringValue(created.NotificationID)
+	plan.OrganizationID = types.StringValue(created.OrganizationID)
+	plan.CreatedAt = types.StringValue(created.CreatedAt)
+
+	diags = resp.State.Set(ctx, plan)
+	resp.Diagnostics.Append(diags...)
+	setIdentity(ctx, resp.State, resp.Identity, &resp.Diagnostics)
+}
+
+func (r *GotifyNotificationResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	var state GotifyNotificationResourceModel
+	diags := req.State.Get(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	notification, err := r.client.GetNotification(state.ID.ValueString())
+	if err != nil {
+		if errors.Is(err, client.ErrNotFound) {
+			resp.State.RemoveResource(ctx)
+			return
+		}
+		resp.Diagnostics.AddError("Error reading Gotify notification", err.Error())
+		return
+	}
+
+	if err := state.mapFromAPI(notification); err != nil {
+		resp.Diagnostics.AddError("Error reading Gotify notification", err.Error())
+		return
+	}
+
+	diags = resp.State.Set(ctx, state)
+	resp.Diagnostics.Append(diags...)
+	setIdentity(ctx, resp.State, resp.Identity, &resp.Diagnostics)
+}
+
+func (r *GotifyNotificationResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	var plan GotifyNotificationResourceModel
+	diags := req.Plan.Get(ctx, &plan)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	var state GotifyNotificationResourceModel
+	diags = req.State.Get(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	updated, err := r.client.UpdateNotification(state.ID.ValueString(), plan.payload())
+	if err != nil {
+		resp.Diagnostics.AddError("Error updating Gotify notification", err.Error())
+		return
+	}
+
+	plan.ID = state.ID
+	plan.OrganizationID = types.StringValue(updated.OrganizationID)
+	plan.CreatedAt = state.CreatedAt
+
+	diags = resp.State.Set(ctx, plan)
+	resp.Diagnostics.Append(diags...)
+	setIdentity(ctx, resp.State, resp.Identity, &resp.Diagnostics)
+}
+
+func (r *GotifyNotificationResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	var state GotifyNotificationResourceModel
+	diags := req.State.Get(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	err := r.client.DeleteNotification(state.ID.ValueString())
+	if err != nil && !errors.Is(err, client.ErrNotFound) {
+		resp.Diagnostics.AddError("Error deleting Gotify notification", err.Error())
+		return
+	}
+}
+
+func (r *GotifyNotificationResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	resource.ImportStatePassthroughWithIdentity(ctx, path.Root("id"), path.Root("id"), req, resp)
+}
@@ -0,0 +1,217 @@
+package provider
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"github.com/ahmedali6/terraform-provider-dokploy/internal/client"
+	"github.com/hashicorp/terraform-plugin-framework-validators/int64validator"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/int64default"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringdefault"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+var _ resource.Resource = &NtfyNotificationResource{}
+var _ resource.ResourceWithImportState = &NtfyNotificationResource{}
+var _ resource.ResourceWithIdentity = &NtfyNotificationResource{}
+
+func NewNtfyNotificationResource() resource.Resource {
+	return &NtfyNotificationResource{}
+}
+
+type NtfyNotificationResource struct {
+	client *client.DokployClient
+}
+
+type NtfyNotificationResourceModel struct {
+	NotificationResourceModel
+	ServerURL   types.String `tfsdk:"server_url"`
+	Topic       types.String `tfsdk:"topic"`
+	AccessToken types.String `tfsdk:"access_token"`
+	Priority    types.Int64  `tfsdk:"priority"`
+}
+
+func (r *NtfyNotificationResource) Metadata(_ context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_notification_ntfy"
+}
+
+func (r *NtfyNotificationResource) Schema(_ context.Context, _ resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Description: "Manages an ntfy notification channel in Dokploy.",
+		Attributes: map[string]schema.Attribute{
+			"server_url": schema.StringAttribute{
+				Optional:    true,
+				Computed:    true,
+				Default:     stringdefault.StaticString("https://ntfy.sh"),
+				Description: "The ntfy server URL. Default: https://ntfy.sh.",
+			},
+			"topic": schema.StringAttribute{
+				Required:    true,
+				Description: "The ntfy topic to publish to.",
+			},
+			"access_token": schema.StringAttribute{
+				Optional:    true,
+				Sensitive:   true,
+				Description: "Access token for protected topics.",
+			},
+			"priority": schema.Int64Attribute{
+				Optional:    true,
+				Computed:    true,
+				Default:     int64default.StaticInt64(3),
+				Description: "The message priority (1-5). Default: 3.",
+				Validators: []validator.Int64{
+					int64validator.Between(1, 5),
+				},
+			},
+		},
+	}
+	for name, attr := range notificationSchemaAttributes() {
+		resp.Schema.Attributes[name] = attr
+	}
+}
+
+func (r *NtfyNotificationResource) IdentitySchema(_ context.Context, _ resource.IdentitySchemaRequest, resp *resource.IdentitySchemaResponse) {
+	resp.IdentitySchema = idIdentitySchema()
+}
+
+func (r *NtfyNotificationResource) Configure(_ context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+	client, ok := req.ProviderData.(*client.DokployClient)
+	if !ok {
+		resp.Diagnostics.AddError("Unexpected Resource Configure Type", fmt.Sprintf("Expected *client.DokployClient, got: %T", req.ProviderData))
+		return
+	}
+	r.client = client
+}
+
+func (m *NtfyNotificationResourceModel) payload() map[string]interface{} {
+	payload := m.NotificationResourceModel.payload()
+	payload["serverUrl"] = m.ServerURL.ValueString()
+	payload["topic"] = m.Topic.ValueString()
+	payload["accessToken"] = m.AccessToken.ValueString()
+	payload["priority"] = m.Priority.ValueInt64()
+	return payload
+}
+
+func (m *NtfyNotificationResourceModel) mapFromAPI(n *client.Notification) error {
+	if err := checkNotificationType(n, "ntfy"); err != nil {
+		return err
+	}
+	if n.Ntfy == nil {
+		return fmt.Errorf("notification %s has no ntfy configuration", n.NotificationID)
+	}
+
+	m.NotificationResourceModel.mapFromAPI(n)
+	m.ServerURL = types.StringValue(n.Ntfy.ServerURL)
+	m.Topic = types.StringValue(n.Ntfy.Topic)
+	m.AccessToken = optionalString(n.Ntfy.AccessToken)
+	m.Priority = types.Int64Value(n.Ntfy.Priority)
+	return nil
+}
+
+func (r *NtfyNotificationResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	var plan NtfyNotificationResourceModel
+	diags := req.Plan.Get(ctx, &plan)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	created, err := r.client.CreateNotification("ntfy", plan.payload())
+	if err != nil {
+		resp.Diagnostics.AddError("Error creating ntfy notification", err.Error())
+		return
+	}
+
+	plan.ID = types.StringValue(created.NotificationID)
+	plan.OrganizationID = types.StringValue(created.OrganizationID)
+	plan.CreatedAt = types.StringValue(created.CreatedAt)
+
+	diags = resp.State.Set(ctx, plan)
+	resp.Diagnostics.Append(diags...)
+	setIdentity(ctx, resp.State, resp.Identity, &resp.Diagnostics)
+}
+
+func (r *NtfyNotificationResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	var state NtfyNotificationResourceModel
+	diags := req.State.Get(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	notification, err := r.client.GetNotification(state.ID.ValueString())
+	if err != nil {
+		if errors.Is(err, client.ErrNotFound) {
+			resp.State.RemoveResource(ctx)
+			return
+		}
+		resp.Diagnostics.AddError("Error reading ntfy notification", err.Error())
+		return
+	}
+
+	if err := state.mapFromAPI(notification); err != nil {
+		resp.Diagnostics.AddError("Error reading ntfy notification", err.Error())
+		return
+	}
+
+	diags = resp.State.Set(ctx, state)
+	resp.Diagnostics.Append(diags...)
+	setIdentity(ctx, resp.State, resp.Identity, &resp.Diagnostics)
+}
+
+func (r *NtfyNotificationResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	var plan NtfyNotificationResourceModel
+	diags := req.Plan.Get(ctx, &plan)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	var state NtfyNotificationResourceModel
+	diags = req.State.Get(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	updated, err := r.client.UpdateNotification(state.ID.ValueString(), plan.payload())
+	if err != nil {
+		resp.Diagnostics.AddError("Error updating ntfy notification", err.Error())
+		return
+	}
+
+	plan.ID = state.ID
+	plan.OrganizationID = types.StringValue(updated.OrganizationID)
+	plan.CreatedAt = state.CreatedAt
+
+	diags = resp.State.Set(ctx, plan)
+	resp.Diagnostics.Append(diags...)
+	setIdentity(ctx, resp.State, resp.Identity, &resp.Diagnostics)
+}
+
+func (r *NtfyNotificationResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	var state NtfyNotificationResourceModel
+	diags := req.State.Get(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	err := r.client.DeleteNotification(state.ID.ValueString())
+	if err != nil && !errors.Is(err, client.ErrNotFound) {
+		resp.Diagnostics.AddError("Error deleting ntfy notification", err.Error())
+		return
+	}
+}
+
+func (r *NtfyNotificationResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	resource.ImportStatePassthroughWithIdentity(ctx, path.Root("id"), path.Root("id"), req, resp)
+}
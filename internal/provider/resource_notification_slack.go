@@ -0,0 +1,192 @@
+package provider
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"github.com/ahmedali6/terraform-provider-dokploy/internal/client"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+var _ resource.Resource = &SlackNotificationResource{}
+var _ resource.ResourceWithImportState = &SlackNotificationResource{}
+var _ resource.ResourceWithIdentity = &SlackNotificationResource{}
+
+func NewSlackNotificationResource() resource.Resource {
+	return &SlackNotificationResource{}
+}
+
+type SlackNotificationResource struct {
+	client *client.DokployClient
+}
+
+type SlackNotificationResourceModel struct {
+	NotificationResourceModel
+	WebhookURL types.String `tfsdk:"webhook_url"`
+	Channel    types.String `tfsdk:"channel"`
+}
+
+func (r *SlackNotificationResource) Metadata(_ context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_notification_slack"
+}
+
+func (r *SlackNotificationResource) Schema(_ context.Context, _ resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Description: "Manages a Slack notification channel in Dokploy.",
+		Attributes: map[string]schema.Attribute{
+			"webhook_url": schema.StringAttribute{
+				Required:    true,
+				Sensitive:   true,
+				Description: "The Slack incoming webhook URL.",
+			},
+			"channel": schema.StringAttribute{
+				Optional:    true,
+				Description: "The Slack channel to post to (e.g., '#alerts').",
+			},
+		},
+	}
+	for name, attr := range notificationSchemaAttributes() {
+		resp.Schema.Attributes[name] = attr
+	}
+}
+
+func (r *SlackNotificationResource) IdentitySchema(_ context.Context, _ resource.IdentitySchemaRequest, resp *resource.IdentitySchemaResponse) {
+	resp.IdentitySchema = idIdentitySchema()
+}
+
+func (r *SlackNotificationResource) Configure(_ context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+	client, ok := req.ProviderData.(*client.DokployClient)
+	if !ok {
+		resp.Diagnostics.AddError("Unexpected Resource Configure Type", fmt.Sprintf("Expected *client.DokployClient, got: %T", req.ProviderData))
+		return
+	}
+	r.client = client
+}
+
+func (m *SlackNotificationResourceModel) payload() map[string]interface{} {
+	payload := m.NotificationResourceModel.payload()
+	payload["webhookUrl"] = m.WebhookURL.ValueString()
+	payload["channel"] = m.Channel.ValueString()
+	return payload
+}
+
+func (m *SlackNotificationResourceModel) mapFromAPI(n *client.Notification) error {
+	if err := checkNotificationType(n, "slack"); err != nil {
+		return err
+	}
+	if n.Slack == nil {
+		return fmt.Errorf("notification %s has no slack configuration", n.NotificationID)
+	}
+
+	m.NotificationResourceModel.mapFromAPI(n)
+	m.WebhookURL = types.StringValue(n.Slack.WebhookURL)
+	m.Channel = optionalString(n.Slack.Channel)
+	return nil
+}
+
+func (r *SlackNotificationResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	var plan SlackNotificationResourceModel
+	diags := req.Plan.Get(ctx, &plan)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	created, err := r.client.CreateNotification("slack", plan.payload())
+	if err != nil {
+		resp.Diagnostics.AddError("Error creating Slack notification", err.Error())
+		return
+	}
+
+	plan.ID = types.StringValue(created.NotificationID)
+	plan.OrganizationID = types.StringValue(created.OrganizationID)
+	plan.CreatedAt = types.StringValue(created.CreatedAt)
+
+	diags = resp.State.Set(ctx, plan)
+	resp.Diagnostics.Append(diags...)
+	setIdentity(ctx, resp.State, resp.Identity, &resp.Diagnostics)
+}
+
+func (r *SlackNotificationResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	var state SlackNotificationResourceModel
+	diags := req.State.Get(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	notification, err := r.client.GetNotification(state.ID.ValueString())
+	if err != nil {
+		if errors.Is(err, client.ErrNotFound) {
+			resp.State.RemoveResource(ctx)
+			return
+		}
+		resp.Diagnostics.AddError("Error reading Slack notification", err.Error())
+		return
+	}
+
+	if err := state.mapFromAPI(notification); err != nil {
+		resp.Diagnostics.AddError("Error reading Slack notification", err.Error())
+		return
+	}
+
+	diags = resp.State.Set(ctx, state)
+	resp.Diagnostics.Append(diags...)
+	setIdentity(ctx, resp.State, resp.Identity, &resp.Diagnostics)
+}
+
+func (r *SlackNotificationResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	var plan SlackNotificationResourceModel
+	diags := req.Plan.Get(ctx, &plan)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	var state SlackNotificationResourceModel
+	diags = req.State.Get(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	updated, err := r.client.UpdateNotification(state.ID.ValueString(), plan.payload())
+	if err != nil {
+		resp.Diagnostics.AddError("Error updating Slack notification", err.Error())
+		return
+	}
+
+	plan.ID = state.ID
+	plan.OrganizationID = types.StringValue(updated.OrganizationID)
+	plan.CreatedAt = state.CreatedAt
+
+	diags = resp.State.Set(ctx, plan)
+	resp.Diagnostics.Append(diags...)
+	setIdentity(ctx, resp.State, resp.Identity, &resp.Diagnostics)
+}
+
+func (r *SlackNotificationResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	var state SlackNotificationResourceModel
+	diags := req.State.Get(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	err := r.client.DeleteNotification(state.ID.ValueString())
+	if err != nil && !errors.Is(err, client.ErrNotFound) {
+		resp.Diagnostics.AddError("Error deleting Slack notification", err.Error())
+		return
+	}
+}
+
+func (r *SlackNotificationResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	resource.ImportStatePassthroughWithIdentity(ctx, path.Root("id"), path.Root("id"), req, resp)
+}
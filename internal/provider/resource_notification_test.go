@@ -0,0 +1,115 @@
+package provider
+
+import (
+	"fmt"
+	"os"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+)
+
+func TestAccWebhookNotificationResource(t *testing.T) {
+	host := os.Getenv("DOKPLOY_HOST")
+	apiKey := os.Getenv("DOKPLOY_API_KEY")
+
+	if host == "" || apiKey == "" {
+		t.Skip("DOKPLOY_HOST and DOKPLOY_API_KEY must be set for acceptance tests")
+	}
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			// Create and Read testing
+			{
+				Config: testAccWebhookNotificationResourceConfig("tf-acc-webhook", false),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("dokploy_notification_webhook.test", "name", "tf-acc-webhook"),
+					resource.TestCheckResourceAttr("dokploy_notification_webhook.test", "endpoint", "https://example.com/hooks/dokploy"),
+					resource.TestCheckResourceAttr("dokploy_notification_webhook.test", "headers.Authorization", "Bearer test"),
+					resource.TestCheckResourceAttr("dokploy_notification_webhook.test", "app_build_error", "true"),
+					resource.TestCheckResourceAttr("dokploy_notification_webhook.test", "database_backup", "false"),
+					resource.TestCheckResourceAttrSet("dokploy_notification_webhook.test", "id"),
+					resource.TestCheckResourceAttrSet("dokploy_notification_webhook.test", "organization_id"),
+				),
+			},
+			// Update and Read testing
+			{
+				Config: testAccWebhookNotificationResourceConfig("tf-acc-webhook-updated", true),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("dokploy_notification_webhook.test", "name", "tf-acc-webhook-updated"),
+					resource.TestCheckResourceAttr("dokploy_notification_webhook.test", "database_backup", "true"),
+				),
+			},
+			// ImportState testing
+			{
+				ResourceName:      "dokploy_notification_webhook.test",
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func TestAccNtfyNotificationResource(t *testing.T) {
+	host := os.Getenv("DOKPLOY_HOST")
+	apiKey := os.Getenv("DOKPLOY_API_KEY")
+
+	if host == "" || apiKey == "" {
+		t.Skip("DOKPLOY_HOST and DOKPLOY_API_KEY must be set for acceptance tests")
+	}
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: fmt.Sprintf(`
+provider "dokploy" {
+  host    = "%s"
+  api_key = "%s"
+}
+
+resource "dokploy_notification_ntfy" "test" {
+  name            = "tf-acc-ntfy"
+  topic           = "tf-acc-dokploy"
+  docker_cleanup  = true
+  dokploy_restart = true
+}
+`, os.Getenv("DOKPLOY_HOST"), os.Getenv("DOKPLOY_API_KEY")),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("dokploy_notification_ntfy.test", "server_url", "https://ntfy.sh"),
+					resource.TestCheckResourceAttr("dokploy_notification_ntfy.test", "priority", "3"),
+					resource.TestCheckResourceAttr("dokploy_notification_ntfy.test", "docker_cleanup", "true"),
+					resource.TestCheckResourceAttr("dokploy_notification_ntfy.test", "dokploy_restart", "true"),
+				),
+			},
+			{
+				ResourceName:      "dokploy_notification_ntfy.test",
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func testAccWebhookNotificationResourceConfig(name string, databaseBackup bool) string {
+	return fmt.Sprintf(`
+provider "dokploy" {
+  host    = "%s"
+  api_key = "%s"
+}
+
+resource "dokploy_notification_webhook" "test" {
+  name     = %q
+  endpoint = "https://example.com/hooks/dokploy"
+  headers = {
+    Authorization = "Bearer test"
+  }
+
+  app_deploy      = true
+  app_build_error = true
+  database_backup = %t
+}
+`, os.Getenv("DOKPLOY_HOST"), os.Getenv("DOKPLOY_API_KEY"), name, databaseBackup)
+}
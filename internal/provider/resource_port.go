@@ -7,6 +7,7 @@ import (
 	"github.com/ahmedali6/terraform-provider-dokploy/internal/client"
 	"github.com/hashicorp/terraform-plugin-framework/path"
 	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/identityschema"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/int64planmodifier"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
@@ -17,6 +18,8 @@ import (
 
 var _ resource.Resource = &PortResource{}
 var _ resource.ResourceWithImportState = &PortResource{}
+var _ resource.ResourceWithIdentity = &PortResource{}
+var _ resource.ResourceWithModifyPlan = &PortResource{}
 
 func NewPortResource() resource.Resource {
 	return &PortResource{}
@@ -41,7 +44,8 @@ func (r *PortResource) Metadata(_ context.Context, req resource.MetadataRequest,
 
 func (r *PortResource) Schema(_ context.Context, _ resource.SchemaRequest, resp *resource.SchemaResponse) {
 	resp.Schema = schema.Schema{
-		Description: "Manages a port mapping for a Dokploy application.",
+		Description: "Manages a port mapping for a Dokploy application. " +
+			"Plans fail when another application on the same server already publishes the port over the same protocol.",
 		Attributes: map[string]schema.Attribute{
 			"id": schema.StringAttribute{
 				Computed:    true,
@@ -64,8 +68,11 @@ func (r *PortResource) Schema(_ context.Context, _ resource.SchemaRequest, resp
 			"protocol": schema.StringAttribute{
 				Optional:    true,
 				Computed:    true,
-				Description: "Protocol: tcp or udp.",
+				Description: "Protocol: tcp or udp. Changing this forces a new port.",
 				Default:     stringdefault.StaticString("tcp"),
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
 			},
 			"publish_mode": schema.StringAttribute{
 				Optional:    true,
@@ -84,6 +91,25 @@ func (r *PortResource) Schema(_ context.Context, _ resource.SchemaRequest, resp
 	}
 }
 
+func (r *PortResource) IdentitySchema(_ context.Context, _ resource.IdentitySchemaRequest, resp *resource.IdentitySchemaResponse) {
+	resp.IdentitySchema = identityschema.Schema{
+		Attributes: map[string]identityschema.Attribute{
+			"application_id": identityschema.StringAttribute{
+				RequiredForImport: true,
+				Description:       "The ID of the application.",
+			},
+			"published_port": identityschema.Int64Attribute{
+				RequiredForImport: true,
+				Description:       "The port exposed on the host.",
+			},
+			"protocol": identityschema.StringAttribute{
+				OptionalForImport: true,
+				Description:       "Protocol: tcp or udp. Defaults to tcp.",
+			},
+		},
+	}
+}
+
 func (r *PortResource) Configure(_ context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
 	if req.ProviderData == nil {
 		return
@@ -99,6 +125,44 @@ func (r *PortResource) Configure(_ context.Context, req resource.ConfigureReques
 	r.client = client
 }
 
+func (r *PortResource) ModifyPlan(ctx context.Context, req resource.ModifyPlanRequest, resp *resource.ModifyPlanResponse) {
+	// Resource is being destroyed.
+	if req.Plan.Raw.IsNull() || r.client == nil {
+		return
+	}
+
+	var plan PortResourceModel
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	if resp.Diagnostics.HasError() || plan.PublishedPort.IsUnknown() || plan.Protocol.IsUnknown() || plan.ApplicationID.IsUnknown() {
+		return
+	}
+
+	// Look for another mapping publishing the same port when the mapping changes.
+	var state PortResourceModel
+	if !req.State.Raw.IsNull() {
+		resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+		if resp.Diagnostics.HasError() {
+			return
+		}
+	}
+	if plan.PublishedPort.Equal(state.PublishedPort) && plan.Protocol.Equal(state.Protocol) && plan.ApplicationID.Equal(state.ApplicationID) {
+		return
+	}
+
+	owner, err := findPortConflict(r.client, plan.ApplicationID.ValueString(), plan.PublishedPort.ValueInt64(), plan.Protocol.ValueString(), state.ID.ValueString())
+	if err != nil {
+		resp.Diagnostics.AddWarning("Port Conflict Check Failed", err.Error())
+		return
+	}
+	if owner != "" {
+		resp.Diagnostics.AddAttributeError(
+			path.Root("published_port"),
+			"Port Conflict",
+			fmt.Sprintf("Port %d/%s is already published on this server by %s.", plan.PublishedPort.ValueInt64(), plan.Protocol.ValueString(), owner),
+		)
+	}
+}
+
 func (r *PortResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
 	var plan PortResourceModel
 	diags := req.Plan.Get(ctx, &plan)
@@ -127,6 +191,7 @@ func (r *PortResource) Create(ctx context.Context, req resource.CreateRequest, r
 
 	diags = resp.State.Set(ctx, plan)
 	resp.Diagnostics.Append(diags...)
+	setIdentity(ctx, resp.State, resp.Identity, &resp.Diagnostics)
 }
 
 func (r *PortResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
@@ -151,6 +216,7 @@ func (r *PortResource) Read(ctx context.Context, req resource.ReadRequest, resp
 
 	diags = resp.State.Set(ctx, &state)
 	resp.Diagnostics.Append(diags...)
+	setIdentity(ctx, resp.State, resp.Identity, &resp.Diagnostics)
 }
 
 func (r *PortResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
@@ -182,6 +248,7 @@ func (r *PortResource) Update(ctx context.Context, req resource.UpdateRequest, r
 
 	diags = resp.State.Set(ctx, plan)
 	resp.Diagnostics.Append(diags...)
+	setIdentity(ctx, resp.State, resp.Identity, &resp.Diagnostics)
 }
 
 func (r *PortResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
@@ -199,6 +266,50 @@ func (r *PortResource) Delete(ctx context.Context, req resource.DeleteRequest, r
 	}
 }
 
+// ImportState accepts the port ID, or an identity of the application,
+// published port and protocol.
 func (r *PortResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
-	resource.ImportStatePassthroughID(ctx, path.Root("id"), req, resp)
+	if req.ID != "" || req.Identity == nil {
+		resource.ImportStatePassthroughID(ctx, path.Root("id"), req, resp)
+		return
+	}
+
+	var applicationID string
+	var publishedPort int64
+	var protocol types.String
+	resp.Diagnostics.Append(req.Identity.GetAttribute(ctx, path.Root("application_id"), &applicationID)...)
+	resp.Diagnostics.Append(req.Identity.GetAttribute(ctx, path.Root("published_port"), &publishedPort)...)
+	resp.Diagnostics.Append(req.Identity.GetAttribute(ctx, path.Root("protocol"), &protocol)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	if protocol.IsNull() {
+		protocol = types.StringValue("tcp")
+	}
+
+	ports, err := r.client.GetPortsByApplication(applicationID)
+	if err != nil {
+		resp.Diagnostics.AddError("Error reading ports", err.Error())
+		return
+	}
+	var matches []string
+	for _, port := range ports {
+		if port.PublishedPort == publishedPort && port.Protocol == protocol.ValueString() {
+			matches = append(matches, port.ID)
+		}
+	}
+	switch len(matches) {
+	case 0:
+		resp.Diagnostics.AddError(
+			"Port Not Found",
+			fmt.Sprintf("Application %s does not publish port %d/%s.", applicationID, publishedPort, protocol.ValueString()),
+		)
+	case 1:
+		resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("id"), matches[0])...)
+	default:
+		resp.Diagnostics.AddError(
+			"Ambiguous Port",
+			fmt.Sprintf("Application %s publishes port %d/%s %d times. Import it by ID instead.", applicationID, publishedPort, protocol.ValueString(), len(matches)),
+		)
+	}
 }
@@ -3,9 +3,12 @@ package provider
 import (
 	"fmt"
 	"os"
+	"regexp"
 	"testing"
 
 	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/statecheck"
+	"github.com/hashicorp/terraform-plugin-testing/tfjsonpath"
 )
 
 func TestAccPortResource(t *testing.T) {
@@ -30,6 +33,11 @@ func TestAccPortResource(t *testing.T) {
 					resource.TestCheckResourceAttrSet("dokploy_port.test", "id"),
 					resource.TestCheckResourceAttrSet("dokploy_port.test", "application_id"),
 				),
+				ConfigStateChecks: []statecheck.StateCheck{
+					statecheck.ExpectIdentityValueMatchesState("dokploy_port.test", tfjsonpath.New("application_id")),
+					statecheck.ExpectIdentityValueMatchesState("dokploy_port.test", tfjsonpath.New("published_port")),
+					statecheck.ExpectIdentityValueMatchesState("dokploy_port.test", tfjsonpath.New("protocol")),
+				},
 			},
 			// Update testing - change target_port (in-place update, not replace)
 			{
@@ -46,6 +54,55 @@ func TestAccPortResource(t *testing.T) {
 				ImportState:       true,
 				ImportStateVerify: true,
 			},
+			// ImportState testing with an import block and resource identity
+			{
+				ResourceName:    "dokploy_port.test",
+				ImportState:     true,
+				ImportStateKind: resource.ImportBlockWithResourceIdentity,
+			},
+		},
+	})
+}
+
+func TestAccPortResourceConflict(t *testing.T) {
+	host := os.Getenv("DOKPLOY_HOST")
+	apiKey := os.Getenv("DOKPLOY_API_KEY")
+
+	if host == "" || apiKey == "" {
+		t.Skip("DOKPLOY_HOST and DOKPLOY_API_KEY must be set for acceptance tests")
+	}
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccPortResourceConflictConfig(""),
+			},
+			// The first application already publishes 8090/tcp on the same server
+			{
+				Config: testAccPortResourceConflictConfig(`
+resource "dokploy_port" "second" {
+  application_id = dokploy_application.second.id
+  published_port = 8090
+  target_port    = 80
+}
+`),
+				PlanOnly:    true,
+				ExpectError: regexp.MustCompile(`Port 8090/tcp is already published on this server by port`),
+			},
+			// The same port over another protocol does not collide
+			{
+				Config: testAccPortResourceConflictConfig(`
+resource "dokploy_port" "second" {
+  application_id = dokploy_application.second.id
+  published_port = 8090
+  target_port    = 80
+  protocol       = "udp"
+}
+`),
+				Check: resource.TestCheckResourceAttr("dokploy_port.second", "protocol", "udp"),
+			},
 		},
 	})
 }
@@ -83,3 +140,45 @@ resource "dokploy_port" "test" {
 }
 `, os.Getenv("DOKPLOY_HOST"), os.Getenv("DOKPLOY_API_KEY"), projectName, envName, appName, publishedPort, targetPort, protocol)
 }
+
+func testAccPortResourceConflictConfig(extra string) string {
+	return fmt.Sprintf(`
+provider "dokploy" {
+  host    = "%s"
+  api_key = "%s"
+}
+
+resource "dokploy_project" "test" {
+  name        = "test-port-conflict-project"
+  description = "Test project for port conflict tests"
+}
+
+resource "dokploy_environment" "test" {
+  project_id = dokploy_project.test.id
+  name       = "test-port-conflict-env"
+}
+
+resource "dokploy_application" "first" {
+  environment_id = dokploy_environment.test.id
+  name           = "test-port-conflict-first"
+  build_type     = "nixpacks"
+  source_type    = "docker"
+  docker_image   = "nginx:latest"
+}
+
+resource "dokploy_application" "second" {
+  environment_id = dokploy_environment.test.id
+  name           = "test-port-conflict-second"
+  build_type     = "nixpacks"
+  source_type    = "docker"
+  docker_image   = "nginx:latest"
+}
+
+resource "dokploy_port" "first" {
+  application_id = dokploy_application.first.id
+  published_port = 8090
+  target_port    = 80
+}
+%s
+`, os.Getenv("DOKPLOY_HOST"), os.Getenv("DOKPLOY_API_KEY"), extra)
+}
@@ -3,9 +3,11 @@ package provider
 import (
 	"fmt"
 	"os"
+	"regexp"
 	"testing"
 
 	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/tfversion"
 )
 
 func TestAccPostgresResource(t *testing.T) {
@@ -29,6 +31,10 @@ func TestAccPostgresResource(t *testing.T) {
 					resource.TestCheckResourceAttrSet("dokploy_postgres.test", "environment_id"),
 					resource.TestCheckResourceAttr("dokploy_postgres.test", "database_name", "testdb"),
 					resource.TestCheckResourceAttr("dokploy_postgres.test", "database_user", "testuser"),
+					resource.TestCheckResourceAttrSet("dokploy_postgres.test", "internal_host"),
+					resource.TestCheckResourceAttr("dokploy_postgres.test", "internal_port", "5432"),
+					resource.TestMatchResourceAttr("dokploy_postgres.test", "internal_connection_url", regexp.MustCompile(`^postgres://testuser:test_postgres_password_123@[^:]+:5432/testdb$`)),
+					resource.TestCheckNoResourceAttr("dokploy_postgres.test", "external_connection_url"),
 				),
 			},
 			// Update and Read testing
@@ -44,7 +50,7 @@ func TestAccPostgresResource(t *testing.T) {
 				ResourceName:            "dokploy_postgres.test",
 				ImportState:             true,
 				ImportStateVerify:       true,
-				ImportStateVerifyIgnore: []string{"database_password", "app_name"},
+				ImportStateVerifyIgnore: []string{"database_password", "internal_connection_url", "external_connection_url", "app_name"},
 			},
 		},
 	})
@@ -107,6 +113,73 @@ resource "dokploy_postgres" "test" {
 `, os.Getenv("DOKPLOY_HOST"), os.Getenv("DOKPLOY_API_KEY"), projectName, envName, pgName, appName, dbName, dbUser, description)
 }
 
+// TestAccPostgresResourceWriteOnlyPassword tests database_password_wo, which
+// must never be persisted to state.
+func TestAccPostgresResourceWriteOnlyPassword(t *testing.T) {
+	host := os.Getenv("DOKPLOY_HOST")
+	apiKey := os.Getenv("DOKPLOY_API_KEY")
+
+	if host == "" || apiKey == "" {
+		t.Skip("DOKPLOY_HOST and DOKPLOY_API_KEY must be set for acceptance tests")
+	}
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		TerraformVersionChecks: []tfversion.TerraformVersionCheck{
+			tfversion.SkipBelow(tfversion.Version1_11_0),
+		},
+		Steps: []resource.TestStep{
+			{
+				Config: testAccPostgresResourceConfigWriteOnly("wo_password_1", 1),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttrSet("dokploy_postgres.test", "id"),
+					resource.TestCheckNoResourceAttr("dokploy_postgres.test", "database_password"),
+					resource.TestCheckNoResourceAttr("dokploy_postgres.test", "database_password_wo"),
+					resource.TestCheckResourceAttr("dokploy_postgres.test", "database_password_wo_version", "1"),
+				),
+			},
+			// Rotate the password by bumping the version
+			{
+				Config: testAccPostgresResourceConfigWriteOnly("wo_password_2", 2),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckNoResourceAttr("dokploy_postgres.test", "database_password_wo"),
+					resource.TestCheckResourceAttr("dokploy_postgres.test", "database_password_wo_version", "2"),
+				),
+			},
+		},
+	})
+}
+
+func testAccPostgresResourceConfigWriteOnly(password string, version int) string {
+	return fmt.Sprintf(`
+provider "dokploy" {
+  host    = "%s"
+  api_key = "%s"
+}
+
+resource "dokploy_project" "test" {
+  name        = "test-postgres-wo-project"
+  description = "Test project for PostgreSQL write-only tests"
+}
+
+resource "dokploy_environment" "test" {
+  project_id = dokploy_project.test.id
+  name       = "test-postgres-wo-env"
+}
+
+resource "dokploy_postgres" "test" {
+  name                         = "test-postgres-wo"
+  app_name                     = "testpgwo"
+  database_name                = "testdb"
+  database_user                = "testuser"
+  database_password_wo         = "%s"
+  database_password_wo_version = %d
+  environment_id               = dokploy_environment.test.id
+}
+`, os.Getenv("DOKPLOY_HOST"), os.Getenv("DOKPLOY_API_KEY"), password, version)
+}
+
 // TestAccPostgresResourceExtended tests PostgreSQL with extended settings.
 func TestAccPostgresResourceExtended(t *testing.T) {
 	host := os.Getenv("DOKPLOY_HOST")
@@ -16,6 +16,7 @@ import (
 
 var _ resource.Resource = &RedirectResource{}
 var _ resource.ResourceWithImportState = &RedirectResource{}
+var _ resource.ResourceWithIdentity = &RedirectResource{}
 
 func NewRedirectResource() resource.Resource {
 	return &RedirectResource{}
@@ -73,6 +74,10 @@ func (r *RedirectResource) Schema(_ context.Context, _ resource.SchemaRequest, r
 	}
 }
 
+func (r *RedirectResource) IdentitySchema(_ context.Context, _ resource.IdentitySchemaRequest, resp *resource.IdentitySchemaResponse) {
+	resp.IdentitySchema = idIdentitySchema()
+}
+
 func (r *RedirectResource) Configure(_ context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
 	if req.ProviderData == nil {
 		return
@@ -114,6 +119,7 @@ func (r *RedirectResource) Create(ctx context.Context, req resource.CreateReques
 
 	diags = resp.State.Set(ctx, plan)
 	resp.Diagnostics.Append(diags...)
+	setIdentity(ctx, resp.State, resp.Identity, &resp.Diagnostics)
 }
 
 func (r *RedirectResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
@@ -137,6 +143,7 @@ func (r *RedirectResource) Read(ctx context.Context, req resource.ReadRequest, r
 
 	diags = resp.State.Set(ctx, &state)
 	resp.Diagnostics.Append(diags...)
+	setIdentity(ctx, resp.State, resp.Identity, &resp.Diagnostics)
 }
 
 func (r *RedirectResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
@@ -166,6 +173,7 @@ func (r *RedirectResource) Update(ctx context.Context, req resource.UpdateReques
 
 	diags = resp.State.Set(ctx, plan)
 	resp.Diagnostics.Append(diags...)
+	setIdentity(ctx, resp.State, resp.Identity, &resp.Diagnostics)
 }
 
 func (r *RedirectResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
@@ -184,5 +192,5 @@ func (r *RedirectResource) Delete(ctx context.Context, req resource.DeleteReques
 }
 
 func (r *RedirectResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
-	resource.ImportStatePassthroughID(ctx, path.Root("id"), req, resp)
+	resource.ImportStatePassthroughWithIdentity(ctx, path.Root("id"), path.Root("id"), req, resp)
 }
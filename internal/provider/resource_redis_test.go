@@ -44,7 +44,7 @@ func TestAccRedisResource(t *testing.T) {
 				ResourceName:            "dokploy_redis.test",
 				ImportState:             true,
 				ImportStateVerify:       true,
-				ImportStateVerifyIgnore: []string{"database_password", "app_name_prefix"}, // Password not returned by API, prefix is config-only.
+				ImportStateVerifyIgnore: []string{"database_password", "internal_connection_url", "external_connection_url", "app_name_prefix"}, // Password (and so the URLs) not returned by API, prefix is config-only.
 			},
 		},
 	})
@@ -5,17 +5,21 @@ import (
 	"fmt"
 
 	"github.com/ahmedali6/terraform-provider-dokploy/internal/client"
+	"github.com/hashicorp/terraform-plugin-framework-validators/int64validator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
 	"github.com/hashicorp/terraform-plugin-framework/path"
 	"github.com/hashicorp/terraform-plugin-framework/resource"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringdefault"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
 	"github.com/hashicorp/terraform-plugin-framework/types"
 )
 
 var _ resource.Resource = &RegistryResource{}
 var _ resource.ResourceWithImportState = &RegistryResource{}
+var _ resource.ResourceWithIdentity = &RegistryResource{}
 
 func NewRegistryResource() resource.Resource {
 	return &RegistryResource{}
@@ -26,14 +30,16 @@ type RegistryResource struct {
 }
 
 type RegistryResourceModel struct {
-	ID           types.String `tfsdk:"id"`
-	RegistryName types.String `tfsdk:"registry_name"`
-	Username     types.String `tfsdk:"username"`
-	Password     types.String `tfsdk:"password"`
-	RegistryUrl  types.String `tfsdk:"registry_url"`
-	RegistryType types.String `tfsdk:"registry_type"`
-	ImagePrefix  types.String `tfsdk:"image_prefix"`
-	ServerID     types.String `tfsdk:"server_id"`
+	ID                types.String `tfsdk:"id"`
+	RegistryName      types.String `tfsdk:"registry_name"`
+	Username          types.String `tfsdk:"username"`
+	Password          types.String `tfsdk:"password"`
+	PasswordWO        types.String `tfsdk:"password_wo"`
+	PasswordWOVersion types.Int64  `tfsdk:"password_wo_version"`
+	RegistryUrl       types.String `tfsdk:"registry_url"`
+	RegistryType      types.String `tfsdk:"registry_type"`
+	ImagePrefix       types.String `tfsdk:"image_prefix"`
+	ServerID          types.String `tfsdk:"server_id"`
 }
 
 func (r *RegistryResource) Metadata(_ context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
@@ -60,9 +66,25 @@ func (r *RegistryResource) Schema(_ context.Context, _ resource.SchemaRequest, r
 				Description: "Username for the registry.",
 			},
 			"password": schema.StringAttribute{
-				Required:    true,
+				Optional:    true,
+				Sensitive:   true,
+				Description: "Password for the registry. Exactly one of password or password_wo must be set.",
+				Validators: []validator.String{
+					stringvalidator.ExactlyOneOf(path.MatchRoot("password_wo")),
+				},
+			},
+			"password_wo": schema.StringAttribute{
+				Optional:    true,
 				Sensitive:   true,
-				Description: "Password for the registry.",
+				WriteOnly:   true,
+				Description: "Write-only password for the registry. It is sent to Dokploy but never stored in plan or state. Requires Terraform 1.11 or later.",
+			},
+			"password_wo_version": schema.Int64Attribute{
+				Optional:    true,
+				Description: "Version of password_wo. Change this value to send an updated write-only value to Dokploy.",
+				Validators: []validator.Int64{
+					int64validator.AlsoRequires(path.MatchRoot("password_wo")),
+				},
 			},
 			"registry_url": schema.StringAttribute{
 				Required:    true,
@@ -86,6 +108,10 @@ func (r *RegistryResource) Schema(_ context.Context, _ resource.SchemaRequest, r
 	}
 }
 
+func (r *RegistryResource) IdentitySchema(_ context.Context, _ resource.IdentitySchemaRequest, resp *resource.IdentitySchemaResponse) {
+	resp.IdentitySchema = idIdentitySchema()
+}
+
 func (r *RegistryResource) Configure(_ context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
 	if req.ProviderData == nil {
 		return
@@ -109,10 +135,15 @@ func (r *RegistryResource) Create(ctx context.Context, req resource.CreateReques
 		return
 	}
 
+	password := writeOnlyValue(ctx, req.Config, path.Root("password_wo"), plan.Password, &resp.Diagnostics)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
 	registry := client.Registry{
 		RegistryName: plan.RegistryName.ValueString(),
 		Username:     plan.Username.ValueString(),
-		Password:     plan.Password.ValueString(),
+		Password:     password,
 		RegistryUrl:  plan.RegistryUrl.ValueString(),
 		RegistryType: plan.RegistryType.ValueString(),
 		ImagePrefix:  plan.ImagePrefix.ValueString(),
@@ -130,6 +161,7 @@ func (r *RegistryResource) Create(ctx context.Context, req resource.CreateReques
 
 	diags = resp.State.Set(ctx, plan)
 	resp.Diagnostics.Append(diags...)
+	setIdentity(ctx, resp.State, resp.Identity, &resp.Diagnostics)
 }
 
 func (r *RegistryResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
@@ -158,6 +190,7 @@ func (r *RegistryResource) Read(ctx context.Context, req resource.ReadRequest, r
 
 	diags = resp.State.Set(ctx, &state)
 	resp.Diagnostics.Append(diags...)
+	setIdentity(ctx, resp.State, resp.Identity, &resp.Diagnostics)
 }
 
 func (r *RegistryResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
@@ -168,11 +201,16 @@ func (r *RegistryResource) Update(ctx context.Context, req resource.UpdateReques
 		return
 	}
 
+	password := writeOnlyValue(ctx, req.Config, path.Root("password_wo"), plan.Password, &resp.Diagnostics)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
 	registry := client.Registry{
 		ID:           plan.ID.ValueString(),
 		RegistryName: plan.RegistryName.ValueString(),
 		Username:     plan.Username.ValueString(),
-		Password:     plan.Password.ValueString(),
+		Password:     password,
 		RegistryUrl:  plan.RegistryUrl.ValueString(),
 		RegistryType: plan.RegistryType.ValueString(),
 		ImagePrefix:  plan.ImagePrefix.ValueString(),
@@ -196,6 +234,7 @@ func (r *RegistryResource) Update(ctx context.Context, req resource.UpdateReques
 
 	diags = resp.State.Set(ctx, plan)
 	resp.Diagnostics.Append(diags...)
+	setIdentity(ctx, resp.State, resp.Identity, &resp.Diagnostics)
 }
 
 func (r *RegistryResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
@@ -214,5 +253,5 @@ func (r *RegistryResource) Delete(ctx context.Context, req resource.DeleteReques
 }
 
 func (r *RegistryResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
-	resource.ImportStatePassthroughID(ctx, path.Root("id"), req, resp)
+	resource.ImportStatePassthroughWithIdentity(ctx, path.Root("id"), path.Root("id"), req, resp)
 }
@@ -0,0 +1,372 @@
+package provider
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"github.com/ahmedali6/terraform-provider-dokploy/internal/client"
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/booldefault"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringdefault"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+var _ resource.Resource = &ScheduleResource{}
+var _ resource.ResourceWithImportState = &ScheduleResource{}
+var _ resource.ResourceWithIdentity = &ScheduleResource{}
+var _ resource.ResourceWithValidateConfig = &ScheduleResource{}
+
+func NewScheduleResource() resource.Resource {
+	return &ScheduleResource{}
+}
+
+type ScheduleResource struct {
+	client *client.DokployClient
+}
+
+type ScheduleResourceModel struct {
+	ID             types.String `tfsdk:"id"`
+	Name           types.String `tfsdk:"name"`
+	CronExpression types.String `tfsdk:"cron_expression"`
+	ScheduleType   types.String `tfsdk:"schedule_type"`
+	ApplicationID  types.String `tfsdk:"application_id"`
+	ComposeID      types.String `tfsdk:"compose_id"`
+	ServiceName    types.String `tfsdk:"service_name"`
+	ServerID       types.String `tfsdk:"server_id"`
+	ShellType      types.String `tfsdk:"shell_type"`
+	Command        types.String `tfsdk:"command"`
+	Script         types.String `tfsdk:"script"`
+	Timezone       types.String `tfsdk:"timezone"`
+	Enabled        types.Bool   `tfsdk:"enabled"`
+	RunNow         types.String `tfsdk:"run_now"`
+	AppName        types.String `tfsdk:"app_name"`
+	CreatedAt      types.String `tfsdk:"created_at"`
+}
+
+func (r *ScheduleResource) Metadata(_ context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_schedule"
+}
+
+func (r *ScheduleResource) Schema(_ context.Context, _ resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Description: "Manages a scheduled job in Dokploy that runs a command on a cron schedule inside an application, a compose service, on a remote server or on the Dokploy host.",
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				Computed:    true,
+				Description: "Unique identifier for the schedule.",
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"name": schema.StringAttribute{
+				Required:    true,
+				Description: "Name of the schedule.",
+			},
+			"cron_expression": schema.StringAttribute{
+				Required:    true,
+				Description: "Cron schedule (e.g., '0 3 * * *' for daily at 3 AM). A leading seconds field is also accepted.",
+				Validators: []validator.String{
+					cronExpressionValidator(),
+				},
+			},
+			"schedule_type": schema.StringAttribute{
+				Required:    true,
+				Description: "Target of the schedule: application, compose, server, or dokploy-server.",
+				Validators: []validator.String{
+					stringvalidator.OneOf("application", "compose", "server", "dokploy-server"),
+				},
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"application_id": schema.StringAttribute{
+				Optional:    true,
+				Description: "ID of the application to run the command in. Required when schedule_type is 'application'.",
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"compose_id": schema.StringAttribute{
+				Optional:    true,
+				Description: "ID of the compose stack to run the command in. Required when schedule_type is 'compose'.",
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"service_name": schema.StringAttribute{
+				Optional:    true,
+				Description: "Service within the compose stack to run the command in. Required when schedule_type is 'compose'.",
+			},
+			"server_id": schema.StringAttribute{
+				Optional:    true,
+				Description: "ID of the remote server to run the script on. Required when schedule_type is 'server'.",
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"shell_type": schema.StringAttribute{
+				Optional:    true,
+				Computed:    true,
+				Default:     stringdefault.StaticString("bash"),
+				Description: "Shell used to run the command: bash or sh. Default: bash.",
+				Validators: []validator.String{
+					stringvalidator.OneOf("bash", "sh"),
+				},
+			},
+			"command": schema.StringAttribute{
+				Optional:    true,
+				Description: "Command to run inside the container. Required when schedule_type is 'application' or 'compose'.",
+			},
+			"script": schema.StringAttribute{
+				Optional:    true,
+				Description: "Script to run on the host. Required when schedule_type is 'server' or 'dokploy-server'.",
+			},
+			"timezone": schema.StringAttribute{
+				Optional:    true,
+				Description: "IANA timezone the cron expression is evaluated in (e.g., 'Europe/Berlin'). Defaults to the server timezone.",
+			},
+			"enabled": schema.BoolAttribute{
+				Optional:    true,
+				Computed:    true,
+				Default:     booldefault.StaticBool(true),
+				Description: "Whether the schedule is enabled. Default: true.",
+			},
+			"run_now": schema.StringAttribute{
+				Optional:    true,
+				Description: "Arbitrary value that triggers an immediate run of the schedule whenever it is set or changed (e.g., a timestamp).",
+			},
+			"app_name": schema.StringAttribute{
+				Computed:    true,
+				Description: "Internal name Dokploy assigns to the schedule.",
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"created_at": schema.StringAttribute{
+				Computed:    true,
+				Description: "Timestamp when the schedule was created.",
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+		},
+	}
+}
+
+func (r *ScheduleResource) IdentitySchema(_ context.Context, _ resource.IdentitySchemaRequest, resp *resource.IdentitySchemaResponse) {
+	resp.IdentitySchema = idIdentitySchema()
+}
+
+func (r *ScheduleResource) ValidateConfig(ctx context.Context, req resource.ValidateConfigRequest, resp *resource.ValidateConfigResponse) {
+	var config ScheduleResourceModel
+	resp.Diagnostics.Append(req.Config.Get(ctx, &config)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	if config.ScheduleType.IsNull() || config.ScheduleType.IsUnknown() {
+		return
+	}
+	scheduleType := config.ScheduleType.ValueString()
+
+	requireAttribute := func(name string, value attr.Value, required bool) {
+		switch {
+		case required && value.IsNull():
+			resp.Diagnostics.AddAttributeError(
+				path.Root(name),
+				"Missing Attribute Configuration",
+				fmt.Sprintf("%s is required when schedule_type is %q.", name, scheduleType),
+			)
+		case !required && !value.IsNull():
+			resp.Diagnostics.AddAttributeError(
+				path.Root(name),
+				"Invalid Attribute Configuration",
+				fmt.Sprintf("%s is not supported when schedule_type is %q.", name, scheduleType),
+			)
+		}
+	}
+
+	runsInContainer := scheduleType == "application" || scheduleType == "compose"
+
+	requireAttribute("application_id", config.ApplicationID, scheduleType == "application")
+	requireAttribute("compose_id", config.ComposeID, scheduleType == "compose")
+	requireAttribute("service_name", config.ServiceName, scheduleType == "compose")
+	requireAttribute("server_id", config.ServerID, scheduleType == "server")
+	requireAttribute("command", config.Command, runsInContainer)
+	requireAttribute("script", config.Script, !runsInContainer)
+}
+
+func (r *ScheduleResource) Configure(_ context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+	client, ok := req.ProviderData.(*client.DokployClient)
+	if !ok {
+		resp.Diagnostics.AddError("Unexpected Resource Configure Type", fmt.Sprintf("Expected *client.DokployClient, got: %T", req.ProviderData))
+		return
+	}
+	r.client = client
+}
+
+func scheduleFromPlan(plan *ScheduleResourceModel) client.Schedule {
+	return client.Schedule{
+		Name:           plan.Name.ValueString(),
+		CronExpression: plan.CronExpression.ValueString(),
+		ScheduleType:   plan.ScheduleType.ValueString(),
+		ShellType:      plan.ShellType.ValueString(),
+		Command:        plan.Command.ValueString(),
+		Enabled:        plan.Enabled.ValueBool(),
+		ApplicationID:  plan.ApplicationID.ValueStringPointer(),
+		ComposeID:      plan.ComposeID.ValueStringPointer(),
+		ServiceName:    plan.ServiceName.ValueStringPointer(),
+		ServerID:       plan.ServerID.ValueStringPointer(),
+		Script:         plan.Script.ValueStringPointer(),
+		Timezone:       plan.Timezone.ValueStringPointer(),
+	}
+}
+
+func (r *ScheduleResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	var plan ScheduleResourceModel
+	diags := req.Plan.Get(ctx, &plan)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	created, err := r.client.CreateSchedule(scheduleFromPlan(&plan))
+	if err != nil {
+		resp.Diagnostics.AddError("Error creating schedule", err.Error())
+		return
+	}
+
+	plan.ID = types.StringValue(created.ScheduleID)
+	plan.AppName = types.StringValue(created.AppName)
+	plan.CreatedAt = types.StringValue(created.CreatedAt)
+
+	diags = resp.State.Set(ctx, plan)
+	resp.Diagnostics.Append(diags...)
+	setIdentity(ctx, resp.State, resp.Identity, &resp.Diagnostics)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	if !plan.RunNow.IsNull() {
+		if err := r.client.RunSchedule(created.ScheduleID); err != nil {
+			resp.Diagnostics.AddWarning("Schedule Run Failed", fmt.Sprintf("Schedule created but the run_now trigger failed: %s", err.Error()))
+		}
+	}
+}
+
+func (r *ScheduleResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	var state ScheduleResourceModel
+	diags := req.State.Get(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	schedule, err := r.client.GetSchedule(state.ID.ValueString())
+	if err != nil {
+		if errors.Is(err, client.ErrNotFound) {
+			resp.State.RemoveResource(ctx)
+			return
+		}
+		resp.Diagnostics.AddError("Error reading schedule", err.Error())
+		return
+	}
+
+	state.Name = types.StringValue(schedule.Name)
+	state.CronExpression = types.StringValue(schedule.CronExpression)
+	state.ScheduleType = types.StringValue(schedule.ScheduleType)
+	state.ShellType = types.StringValue(schedule.ShellType)
+	state.Enabled = types.BoolValue(schedule.Enabled)
+	state.AppName = types.StringValue(schedule.AppName)
+	state.CreatedAt = types.StringValue(schedule.CreatedAt)
+
+	state.ApplicationID = optionalStringPointer(schedule.ApplicationID)
+	state.ComposeID = optionalStringPointer(schedule.ComposeID)
+	state.ServiceName = optionalStringPointer(schedule.ServiceName)
+	state.Script = optionalStringPointer(schedule.Script)
+	state.Timezone = optionalStringPointer(schedule.Timezone)
+	// Dokploy host schedules carry the host's server reference, which is not
+	// part of the configuration.
+	if schedule.ScheduleType == "server" {
+		state.ServerID = optionalStringPointer(schedule.ServerID)
+	}
+	if schedule.Command != "" || !state.Command.IsNull() {
+		state.Command = types.StringValue(schedule.Command)
+	}
+
+	diags = resp.State.Set(ctx, state)
+	resp.Diagnostics.Append(diags...)
+	setIdentity(ctx, resp.State, resp.Identity, &resp.Diagnostics)
+}
+
+func (r *ScheduleResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	var plan ScheduleResourceModel
+	diags := req.Plan.Get(ctx, &plan)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	var state ScheduleResourceModel
+	diags = req.State.Get(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	schedule := scheduleFromPlan(&plan)
+	schedule.ScheduleID = state.ID.ValueString()
+
+	updated, err := r.client.UpdateSchedule(schedule)
+	if err != nil {
+		resp.Diagnostics.AddError("Error updating schedule", err.Error())
+		return
+	}
+
+	plan.ID = state.ID
+	plan.AppName = types.StringValue(updated.AppName)
+	plan.CreatedAt = state.CreatedAt
+
+	diags = resp.State.Set(ctx, plan)
+	resp.Diagnostics.Append(diags...)
+	setIdentity(ctx, resp.State, resp.Identity, &resp.Diagnostics)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	if !plan.RunNow.IsNull() && !plan.RunNow.Equal(state.RunNow) {
+		if err := r.client.RunSchedule(state.ID.ValueString()); err != nil {
+			resp.Diagnostics.AddWarning("Schedule Run Failed", fmt.Sprintf("Schedule updated but the run_now trigger failed: %s", err.Error()))
+		}
+	}
+}
+
+func (r *ScheduleResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	var state ScheduleResourceModel
+	diags := req.State.Get(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	err := r.client.DeleteSchedule(state.ID.ValueString())
+	if err != nil && !errors.Is(err, client.ErrNotFound) {
+		resp.Diagnostics.AddError("Error deleting schedule", err.Error())
+		return
+	}
+}
+
+func (r *ScheduleResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	resource.ImportStatePassthroughWithIdentity(ctx, path.Root("id"), path.Root("id"), req, resp)
+}
@@ -0,0 +1,153 @@
+package provider
+
+import (
+	"fmt"
+	"os"
+	"regexp"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+)
+
+func TestAccScheduleResource(t *testing.T) {
+	host := os.Getenv("DOKPLOY_HOST")
+	apiKey := os.Getenv("DOKPLOY_API_KEY")
+
+	if host == "" || apiKey == "" {
+		t.Skip("DOKPLOY_HOST and DOKPLOY_API_KEY must be set for acceptance tests")
+	}
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			// Create and Read testing
+			{
+				Config: testAccScheduleResourceConfig("cache-warmer", "0 3 * * *", true, ""),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("dokploy_schedule.test", "name", "cache-warmer"),
+					resource.TestCheckResourceAttr("dokploy_schedule.test", "cron_expression", "0 3 * * *"),
+					resource.TestCheckResourceAttr("dokploy_schedule.test", "schedule_type", "application"),
+					resource.TestCheckResourceAttr("dokploy_schedule.test", "shell_type", "bash"),
+					resource.TestCheckResourceAttr("dokploy_schedule.test", "timezone", "Europe/Berlin"),
+					resource.TestCheckResourceAttr("dokploy_schedule.test", "enabled", "true"),
+					resource.TestCheckResourceAttrSet("dokploy_schedule.test", "id"),
+					resource.TestCheckResourceAttrSet("dokploy_schedule.test", "app_name"),
+					resource.TestCheckResourceAttrSet("dokploy_schedule.test", "created_at"),
+				),
+			},
+			// Update and run testing
+			{
+				Config: testAccScheduleResourceConfig("cache-warmer-nightly", "30 2 * * 1-5", false, "run-1"),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("dokploy_schedule.test", "name", "cache-warmer-nightly"),
+					resource.TestCheckResourceAttr("dokploy_schedule.test", "cron_expression", "30 2 * * 1-5"),
+					resource.TestCheckResourceAttr("dokploy_schedule.test", "enabled", "false"),
+					resource.TestCheckResourceAttr("dokploy_schedule.test", "run_now", "run-1"),
+					resource.TestCheckResourceAttrSet("data.dokploy_schedule_runs.test", "runs.#"),
+				),
+			},
+			// ImportState testing
+			{
+				ResourceName:            "dokploy_schedule.test",
+				ImportState:             true,
+				ImportStateVerify:       true,
+				ImportStateVerifyIgnore: []string{"run_now"},
+			},
+		},
+	})
+}
+
+func TestAccScheduleResourceInvalidConfig(t *testing.T) {
+	host := os.Getenv("DOKPLOY_HOST")
+	apiKey := os.Getenv("DOKPLOY_API_KEY")
+
+	if host == "" || apiKey == "" {
+		t.Skip("DOKPLOY_HOST and DOKPLOY_API_KEY must be set for acceptance tests")
+	}
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccScheduleResourceInvalidConfig(`
+  cron_expression = "61 * * * *"
+  schedule_type   = "dokploy-server"
+  script          = "docker system prune -f"
+`),
+				ExpectError: regexp.MustCompile(`Invalid Cron Expression`),
+			},
+			{
+				Config: testAccScheduleResourceInvalidConfig(`
+  cron_expression = "0 * * * *"
+  schedule_type   = "compose"
+  compose_id      = "compose-id"
+  command         = "echo hi"
+`),
+				ExpectError: regexp.MustCompile(`service_name is required when schedule_type is "compose"`),
+			},
+		},
+	})
+}
+
+func testAccScheduleResourceConfig(name, cron string, enabled bool, runNow string) string {
+	runNowAttr := ""
+	dataSource := ""
+	if runNow != "" {
+		runNowAttr = fmt.Sprintf("run_now = %q", runNow)
+		dataSource = `
+data "dokploy_schedule_runs" "test" {
+  schedule_id = dokploy_schedule.test.id
+}
+`
+	}
+
+	return fmt.Sprintf(`
+provider "dokploy" {
+  host    = "%s"
+  api_key = "%s"
+}
+
+resource "dokploy_project" "test" {
+  name        = "test-schedule-project"
+  description = "Test project for schedule tests"
+}
+
+resource "dokploy_environment" "test" {
+  project_id = dokploy_project.test.id
+  name       = "test-schedule-env"
+}
+
+resource "dokploy_application" "test" {
+  environment_id = dokploy_environment.test.id
+  name           = "test-schedule-app"
+  source_type    = "docker"
+  docker_image   = "nginx:latest"
+}
+
+resource "dokploy_schedule" "test" {
+  name            = %q
+  cron_expression = %q
+  schedule_type   = "application"
+  application_id  = dokploy_application.test.id
+  command         = "echo warming cache"
+  timezone        = "Europe/Berlin"
+  enabled         = %t
+  %s
+}
+%s`, os.Getenv("DOKPLOY_HOST"), os.Getenv("DOKPLOY_API_KEY"), name, cron, enabled, runNowAttr, dataSource)
+}
+
+func testAccScheduleResourceInvalidConfig(attrs string) string {
+	return fmt.Sprintf(`
+provider "dokploy" {
+  host    = "%s"
+  api_key = "%s"
+}
+
+resource "dokploy_schedule" "test" {
+  name = "invalid-schedule"
+%s}
+`, os.Getenv("DOKPLOY_HOST"), os.Getenv("DOKPLOY_API_KEY"), attrs)
+}
@@ -0,0 +1,197 @@
+package provider
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"github.com/ahmedali6/terraform-provider-dokploy/internal/client"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/identityschema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+var _ resource.Resource = &ServerSettingsResource{}
+var _ resource.ResourceWithImportState = &ServerSettingsResource{}
+var _ resource.ResourceWithIdentity = &ServerSettingsResource{}
+
+func NewServerSettingsResource() resource.Resource {
+	return &ServerSettingsResource{}
+}
+
+type ServerSettingsResource struct {
+	client *client.DokployClient
+}
+
+type ServerSettingsResourceModel struct {
+	HostSettingsModel
+	ID       types.String `tfsdk:"id"`
+	ServerID types.String `tfsdk:"server_id"`
+}
+
+func (r *ServerSettingsResource) Metadata(_ context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_server_settings"
+}
+
+func (r *ServerSettingsResource) Schema(_ context.Context, _ resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Description: "Manages the Traefik configuration and dashboard and the Docker cleanup of a remote Dokploy server. " +
+			"Destroying the resource leaves the current settings in place.",
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				Computed:    true,
+				Description: "Same as server_id.",
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"server_id": schema.StringAttribute{
+				Required:    true,
+				Description: "The ID of the server.",
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+		},
+	}
+	for name, attr := range hostSettingsSchemaAttributes() {
+		resp.Schema.Attributes[name] = attr
+	}
+}
+
+func (r *ServerSettingsResource) IdentitySchema(_ context.Context, _ resource.IdentitySchemaRequest, resp *resource.IdentitySchemaResponse) {
+	resp.IdentitySchema = identityschema.Schema{
+		Attributes: map[string]identityschema.Attribute{
+			"server_id": identityschema.StringAttribute{
+				RequiredForImport: true,
+				Description:       "The ID of the server.",
+			},
+		},
+	}
+}
+
+func (r *ServerSettingsResource) Configure(_ context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+	client, ok := req.ProviderData.(*client.DokployClient)
+	if !ok {
+		resp.Diagnostics.AddError("Unexpected Resource Configure Type", fmt.Sprintf("Expected *client.DokployClient, got: %T", req.ProviderData))
+		return
+	}
+	r.client = client
+}
+
+// read refreshes all settings from the server.
+func (r *ServerSettingsResource) read(state *ServerSettingsResourceModel) error {
+	server, err := r.client.GetServer(state.ServerID.ValueString())
+	if err != nil {
+		return err
+	}
+	if err := state.HostSettingsModel.read(r.client, server.ID); err != nil {
+		return err
+	}
+
+	state.ID = types.StringValue(server.ID)
+	state.ServerID = types.StringValue(server.ID)
+	state.EnableDockerCleanup = types.BoolValue(server.EnableDockerCleanup)
+	return nil
+}
+
+func (r *ServerSettingsResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	var plan ServerSettingsResourceModel
+	diags := req.Plan.Get(ctx, &plan)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	if err := plan.HostSettingsModel.apply(r.client, plan.ServerID.ValueString(), &HostSettingsModel{}); err != nil {
+		resp.Diagnostics.AddError("Error updating server settings", err.Error())
+		return
+	}
+
+	if err := r.read(&plan); err != nil {
+		resp.Diagnostics.AddError("Error reading server settings", err.Error())
+		return
+	}
+
+	diags = resp.State.Set(ctx, plan)
+	resp.Diagnostics.Append(diags...)
+	setIdentity(ctx, resp.State, resp.Identity, &resp.Diagnostics)
+}
+
+func (r *ServerSettingsResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	var state ServerSettingsResourceModel
+	diags := req.State.Get(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Import sets only the ID.
+	if state.ServerID.IsNull() {
+		state.ServerID = state.ID
+	}
+
+	if err := r.read(&state); err != nil {
+		if errors.Is(err, client.ErrNotFound) {
+			resp.State.RemoveResource(ctx)
+			return
+		}
+		resp.Diagnostics.AddError("Error reading server settings", err.Error())
+		return
+	}
+
+	diags = resp.State.Set(ctx, state)
+	resp.Diagnostics.Append(diags...)
+	setIdentity(ctx, resp.State, resp.Identity, &resp.Diagnostics)
+}
+
+func (r *ServerSettingsResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	var plan ServerSettingsResourceModel
+	diags := req.Plan.Get(ctx, &plan)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	var state ServerSettingsResourceModel
+	diags = req.State.Get(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	if err := plan.HostSettingsModel.apply(r.client, plan.ServerID.ValueString(), &state.HostSettingsModel); err != nil {
+		resp.Diagnostics.AddError("Error updating server settings", err.Error())
+		return
+	}
+
+	if err := r.read(&plan); err != nil {
+		resp.Diagnostics.AddError("Error reading server settings", err.Error())
+		return
+	}
+
+	diags = resp.State.Set(ctx, plan)
+	resp.Diagnostics.Append(diags...)
+	setIdentity(ctx, resp.State, resp.Identity, &resp.Diagnostics)
+}
+
+func (r *ServerSettingsResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	// The settings cannot be deleted; they are only removed from state.
+}
+
+func (r *ServerSettingsResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	if importStateFromIdentity(ctx, req, resp) {
+		var serverID types.String
+		resp.Diagnostics.Append(resp.State.GetAttribute(ctx, path.Root("server_id"), &serverID)...)
+		resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("id"), serverID)...)
+		return
+	}
+	resource.ImportStatePassthroughID(ctx, path.Root("id"), req, resp)
+}
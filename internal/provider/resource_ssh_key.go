@@ -6,16 +6,21 @@ import (
 	"strings"
 
 	"github.com/ahmedali6/terraform-provider-dokploy/internal/client"
+	"github.com/hashicorp/terraform-plugin-framework-validators/int64validator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
 	"github.com/hashicorp/terraform-plugin-framework/path"
 	"github.com/hashicorp/terraform-plugin-framework/resource"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/int64planmodifier"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
 	"github.com/hashicorp/terraform-plugin-framework/types"
 )
 
 var _ resource.Resource = &SSHKeyResource{}
 var _ resource.ResourceWithImportState = &SSHKeyResource{}
+var _ resource.ResourceWithIdentity = &SSHKeyResource{}
 
 func NewSSHKeyResource() resource.Resource {
 	return &SSHKeyResource{}
@@ -26,11 +31,13 @@ type SSHKeyResource struct {
 }
 
 type SSHKeyResourceModel struct {
-	ID          types.String `tfsdk:"id"`
-	Name        types.String `tfsdk:"name"`
-	Description types.String `tfsdk:"description"`
-	PrivateKey  types.String `tfsdk:"private_key"`
-	PublicKey   types.String `tfsdk:"public_key"`
+	ID                  types.String `tfsdk:"id"`
+	Name                types.String `tfsdk:"name"`
+	Description         types.String `tfsdk:"description"`
+	PrivateKey          types.String `tfsdk:"private_key"`
+	PrivateKeyWO        types.String `tfsdk:"private_key_wo"`
+	PrivateKeyWOVersion types.Int64  `tfsdk:"private_key_wo_version"`
+	PublicKey           types.String `tfsdk:"public_key"`
 }
 
 func (r *SSHKeyResource) Metadata(_ context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
@@ -53,12 +60,31 @@ func (r *SSHKeyResource) Schema(_ context.Context, _ resource.SchemaRequest, res
 				Optional: true,
 			},
 			"private_key": schema.StringAttribute{
-				Required:  true,
+				Optional:  true,
 				Sensitive: true,
+				Validators: []validator.String{
+					stringvalidator.ExactlyOneOf(path.MatchRoot("private_key_wo")),
+				},
 				PlanModifiers: []planmodifier.String{
 					stringplanmodifier.RequiresReplace(),
 				},
 			},
+			"private_key_wo": schema.StringAttribute{
+				Optional:    true,
+				Sensitive:   true,
+				WriteOnly:   true,
+				Description: "Write-only private key. It is sent to Dokploy but never stored in plan or state. Requires Terraform 1.11 or later.",
+			},
+			"private_key_wo_version": schema.Int64Attribute{
+				Optional:    true,
+				Description: "Version of private_key_wo. Change this value to send an updated write-only value to Dokploy.",
+				Validators: []validator.Int64{
+					int64validator.AlsoRequires(path.MatchRoot("private_key_wo")),
+				},
+				PlanModifiers: []planmodifier.Int64{
+					int64planmodifier.RequiresReplace(),
+				},
+			},
 			"public_key": schema.StringAttribute{
 				Required: true,
 				PlanModifiers: []planmodifier.String{
@@ -69,6 +95,10 @@ func (r *SSHKeyResource) Schema(_ context.Context, _ resource.SchemaRequest, res
 	}
 }
 
+func (r *SSHKeyResource) IdentitySchema(_ context.Context, _ resource.IdentitySchemaRequest, resp *resource.IdentitySchemaResponse) {
+	resp.IdentitySchema = idIdentitySchema()
+}
+
 func (r *SSHKeyResource) Configure(_ context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
 	if req.ProviderData == nil {
 		return
@@ -89,10 +119,15 @@ func (r *SSHKeyResource) Create(ctx context.Context, req resource.CreateRequest,
 		return
 	}
 
+	privateKey := writeOnlyValue(ctx, req.Config, path.Root("private_key_wo"), plan.PrivateKey, &resp.Diagnostics)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
 	key, err := r.client.CreateSSHKey(
 		plan.Name.ValueString(),
 		plan.Description.ValueString(),
-		plan.PrivateKey.ValueString(),
+		privateKey,
 		plan.PublicKey.ValueString(),
 	)
 	if err != nil {
@@ -104,6 +139,7 @@ func (r *SSHKeyResource) Create(ctx context.Context, req resource.CreateRequest,
 
 	diags = resp.State.Set(ctx, plan)
 	resp.Diagnostics.Append(diags...)
+	setIdentity(ctx, resp.State, resp.Identity, &resp.Diagnostics)
 }
 
 func (r *SSHKeyResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
@@ -129,17 +165,16 @@ func (r *SSHKeyResource) Read(ctx context.Context, req resource.ReadRequest, res
 	if key.Description != "" || !state.Description.IsNull() {
 		state.Description = types.StringValue(key.Description)
 	}
-	// For private_key and public_key: preserve from state if set (to avoid drift from
-	// formatting differences), but fetch from API if state is empty (e.g., during import)
+	// For public_key: preserve from state if set (to avoid drift from
+	// formatting differences), but fetch from API if state is empty (e.g., during import).
+	// private_key is only backfilled on import, since it is null when private_key_wo is used.
 	if state.PublicKey.IsNull() || state.PublicKey.ValueString() == "" {
 		state.PublicKey = types.StringValue(strings.TrimSpace(key.PublicKey))
 	}
-	if state.PrivateKey.IsNull() || state.PrivateKey.ValueString() == "" {
-		state.PrivateKey = types.StringValue(key.PrivateKey)
-	}
 
 	diags = resp.State.Set(ctx, state)
 	resp.Diagnostics.Append(diags...)
+	setIdentity(ctx, resp.State, resp.Identity, &resp.Diagnostics)
 }
 
 func (r *SSHKeyResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
@@ -186,9 +221,11 @@ func (r *SSHKeyResource) Update(ctx context.Context, req resource.UpdateRequest,
 	// so they won't change during update, but we need to ensure they're preserved)
 	newState.PublicKey = plan.PublicKey
 	newState.PrivateKey = plan.PrivateKey
+	newState.PrivateKeyWOVersion = plan.PrivateKeyWOVersion
 
 	diags = resp.State.Set(ctx, newState)
 	resp.Diagnostics.Append(diags...)
+	setIdentity(ctx, resp.State, resp.Identity, &resp.Diagnostics)
 }
 
 func (r *SSHKeyResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
@@ -210,5 +247,12 @@ func (r *SSHKeyResource) Delete(ctx context.Context, req resource.DeleteRequest,
 }
 
 func (r *SSHKeyResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
-	resource.ImportStatePassthroughID(ctx, path.Root("id"), req, resp)
+	resource.ImportStatePassthroughWithIdentity(ctx, path.Root("id"), path.Root("id"), req, resp)
+
+	key, err := r.client.GetSSHKey(req.ID)
+	if err != nil {
+		resp.Diagnostics.AddError("Error reading SSH Key", err.Error())
+		return
+	}
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("private_key"), key.PrivateKey)...)
 }
@@ -0,0 +1,238 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+	"regexp"
+	"strings"
+
+	"github.com/ahmedali6/terraform-provider-dokploy/internal/client"
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/identityschema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+var _ resource.Resource = &TraefikFileResource{}
+var _ resource.ResourceWithImportState = &TraefikFileResource{}
+var _ resource.ResourceWithIdentity = &TraefikFileResource{}
+
+// traefikFileNamePattern restricts file names to YAML files directly inside
+// the dynamic configuration directory.
+var traefikFileNamePattern = regexp.MustCompile(`^[A-Za-z0-9][A-Za-z0-9._-]*\.ya?ml$`)
+
+func NewTraefikFileResource() resource.Resource {
+	return &TraefikFileResource{}
+}
+
+type TraefikFileResource struct {
+	client *client.DokployClient
+}
+
+type TraefikFileResourceModel struct {
+	ID       types.String `tfsdk:"id"`
+	Name     types.String `tfsdk:"name"`
+	ServerID types.String `tfsdk:"server_id"`
+	Content  YAMLValue    `tfsdk:"content"`
+	Path     types.String `tfsdk:"path"`
+}
+
+func (r *TraefikFileResource) Metadata(_ context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_traefik_file"
+}
+
+func (r *TraefikFileResource) Schema(_ context.Context, _ resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Description: "Manages a Traefik dynamic configuration file on the Dokploy host or a remote server. " +
+			"Destroying the resource empties the file, as the Dokploy API cannot delete it.",
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				Computed:    true,
+				Description: "The file name, prefixed with '<server_id>:' for files on a remote server.",
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"name": schema.StringAttribute{
+				Required:    true,
+				Description: "File name inside the Traefik dynamic configuration directory (e.g., 'rate-limit.yml').",
+				Validators: []validator.String{
+					stringvalidator.RegexMatches(traefikFileNamePattern, "must be a .yml or .yaml file name without directories"),
+				},
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"server_id": schema.StringAttribute{
+				Optional:    true,
+				Description: "ID of the remote server holding the file. Omit for the Dokploy host.",
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"content": schema.StringAttribute{
+				CustomType:  YAMLType{},
+				Required:    true,
+				Description: "YAML content of the file. Formatting, comments and key order are ignored when detecting drift.",
+				Validators: []validator.String{
+					stringvalidator.LengthAtLeast(1),
+				},
+			},
+			"path": schema.StringAttribute{
+				Computed:    true,
+				Description: "Absolute path of the file.",
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+		},
+	}
+}
+
+func (r *TraefikFileResource) IdentitySchema(_ context.Context, _ resource.IdentitySchemaRequest, resp *resource.IdentitySchemaResponse) {
+	resp.IdentitySchema = identityschema.Schema{
+		Attributes: map[string]identityschema.Attribute{
+			"name": identityschema.StringAttribute{
+				RequiredForImport: true,
+				Description:       "The file name.",
+			},
+			"server_id": identityschema.StringAttribute{
+				OptionalForImport: true,
+				Description:       "ID of the remote server the file is on. Omit for the Dokploy host.",
+			},
+		},
+	}
+}
+
+func (r *TraefikFileResource) Configure(_ context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+	client, ok := req.ProviderData.(*client.DokployClient)
+	if !ok {
+		resp.Diagnostics.AddError("Unexpected Resource Configure Type", fmt.Sprintf("Expected *client.DokployClient, got: %T", req.ProviderData))
+		return
+	}
+	r.client = client
+}
+
+func (m *TraefikFileResourceModel) setComputed() {
+	m.Path = types.StringValue(client.TraefikDynamicConfigDir + "/" + m.Name.ValueString())
+	if m.ServerID.ValueString() != "" {
+		m.ID = types.StringValue(m.ServerID.ValueString() + ":" + m.Name.ValueString())
+	} else {
+		m.ID = types.StringValue(m.Name.ValueString())
+	}
+}
+
+func (r *TraefikFileResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	var plan TraefikFileResourceModel
+	diags := req.Plan.Get(ctx, &plan)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	plan.setComputed()
+	err := r.client.UpdateTraefikFile(plan.Path.ValueString(), plan.Content.ValueString(), plan.ServerID.ValueString())
+	if err != nil {
+		resp.Diagnostics.AddError("Error writing Traefik file", err.Error())
+		return
+	}
+
+	diags = resp.State.Set(ctx, plan)
+	resp.Diagnostics.Append(diags...)
+	setIdentity(ctx, resp.State, resp.Identity, &resp.Diagnostics)
+}
+
+func (r *TraefikFileResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	var state TraefikFileResourceModel
+	diags := req.State.Get(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	content, err := r.client.ReadTraefikFile(state.Path.ValueString(), state.ServerID.ValueString())
+	if err != nil {
+		resp.Diagnostics.AddError("Error reading Traefik file", err.Error())
+		return
+	}
+
+	// A missing file reads as empty, which is also what Delete leaves behind.
+	if content == "" {
+		resp.State.RemoveResource(ctx)
+		return
+	}
+
+	state.Content = NewYAMLValue(content)
+
+	diags = resp.State.Set(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+	setIdentity(ctx, resp.State, resp.Identity, &resp.Diagnostics)
+}
+
+func (r *TraefikFileResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	var plan TraefikFileResourceModel
+	diags := req.Plan.Get(ctx, &plan)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	plan.setComputed()
+	err := r.client.UpdateTraefikFile(plan.Path.ValueString(), plan.Content.ValueString(), plan.ServerID.ValueString())
+	if err != nil {
+		resp.Diagnostics.AddError("Error writing Traefik file", err.Error())
+		return
+	}
+
+	diags = resp.State.Set(ctx, plan)
+	resp.Diagnostics.Append(diags...)
+	setIdentity(ctx, resp.State, resp.Identity, &resp.Diagnostics)
+}
+
+func (r *TraefikFileResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	var state TraefikFileResourceModel
+	diags := req.State.Get(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	err := r.client.UpdateTraefikFile(state.Path.ValueString(), "", state.ServerID.ValueString())
+	if err != nil {
+		resp.Diagnostics.AddError("Error emptying Traefik file", err.Error())
+		return
+	}
+}
+
+func (r *TraefikFileResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	// Import format: name, or server_id:name for a file on a remote server
+	state := TraefikFileResourceModel{Name: types.StringValue(req.ID)}
+	if importStateFromIdentity(ctx, req, resp) {
+		resp.Diagnostics.Append(req.Identity.GetAttribute(ctx, path.Root("name"), &state.Name)...)
+		resp.Diagnostics.Append(req.Identity.GetAttribute(ctx, path.Root("server_id"), &state.ServerID)...)
+	} else if serverID, name, ok := strings.Cut(req.ID, ":"); ok {
+		state.ServerID = types.StringValue(serverID)
+		state.Name = types.StringValue(name)
+	}
+	if !traefikFileNamePattern.MatchString(state.Name.ValueString()) {
+		resp.Diagnostics.AddError(
+			"Invalid Import ID",
+			fmt.Sprintf("Expected import ID format: name or server_id:name, with a .yml or .yaml file name, got: %s", req.ID),
+		)
+		return
+	}
+	state.setComputed()
+
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("id"), state.ID)...)
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("name"), state.Name)...)
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("server_id"), state.ServerID)...)
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("path"), state.Path)...)
+}
@@ -0,0 +1,69 @@
+package provider
+
+import (
+	"fmt"
+	"os"
+	"regexp"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+)
+
+func TestAccTraefikFileResource(t *testing.T) {
+	if os.Getenv("DOKPLOY_HOST") == "" || os.Getenv("DOKPLOY_API_KEY") == "" {
+		t.Skip("DOKPLOY_HOST and DOKPLOY_API_KEY must be set for acceptance tests")
+	}
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			// Invalid YAML is rejected at plan time
+			{
+				Config:      testAccTraefikFileResourceConfig("http:\n  middlewares: [\n"),
+				ExpectError: regexp.MustCompile("Invalid YAML"),
+			},
+			// Create and Read testing
+			{
+				Config: testAccTraefikFileResourceConfig("http:\n  middlewares:\n    tf-test-rate-limit:\n      rateLimit:\n        average: 100\n"),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("dokploy_traefik_file.test", "id", "tf-test-middlewares.yml"),
+					resource.TestCheckResourceAttr("dokploy_traefik_file.test", "path", "/etc/dokploy/traefik/dynamic/tf-test-middlewares.yml"),
+					resource.TestMatchResourceAttr("dokploy_traefik_file.test", "content", regexp.MustCompile("average: 100")),
+				),
+			},
+			// Update and Read testing
+			{
+				Config: testAccTraefikFileResourceConfig("http:\n  middlewares:\n    tf-test-rate-limit:\n      rateLimit:\n        average: 50\n"),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestMatchResourceAttr("dokploy_traefik_file.test", "content", regexp.MustCompile("average: 50")),
+				),
+			},
+			// Reformatted content is semantically equal and plans no changes
+			{
+				Config:   testAccTraefikFileResourceConfig("# rate limits\nhttp:\n    middlewares:\n        tf-test-rate-limit: {rateLimit: {average: 50}}\n"),
+				PlanOnly: true,
+			},
+			// ImportState testing
+			{
+				ResourceName:      "dokploy_traefik_file.test",
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func testAccTraefikFileResourceConfig(content string) string {
+	return fmt.Sprintf(`
+provider "dokploy" {
+  host    = "%s"
+  api_key = "%s"
+}
+
+resource "dokploy_traefik_file" "test" {
+  name    = "tf-test-middlewares.yml"
+  content = %q
+}
+`, os.Getenv("DOKPLOY_HOST"), os.Getenv("DOKPLOY_API_KEY"), content)
+}
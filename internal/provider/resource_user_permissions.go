@@ -8,6 +8,7 @@ import (
 	"github.com/ahmedali6/terraform-provider-dokploy/internal/client"
 	"github.com/hashicorp/terraform-plugin-framework/path"
 	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/identityschema"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/booldefault"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
@@ -17,6 +18,7 @@ import (
 
 var _ resource.Resource = &UserPermissionsResource{}
 var _ resource.ResourceWithImportState = &UserPermissionsResource{}
+var _ resource.ResourceWithIdentity = &UserPermissionsResource{}
 
 func NewUserPermissionsResource() resource.Resource {
 	return &UserPermissionsResource{}
@@ -155,6 +157,17 @@ func (r *UserPermissionsResource) Schema(_ context.Context, _ resource.SchemaReq
 	}
 }
 
+func (r *UserPermissionsResource) IdentitySchema(_ context.Context, _ resource.IdentitySchemaRequest, resp *resource.IdentitySchemaResponse) {
+	resp.IdentitySchema = identityschema.Schema{
+		Attributes: map[string]identityschema.Attribute{
+			"member_id": identityschema.StringAttribute{
+				RequiredForImport: true,
+				Description:       "The ID of the organization member.",
+			},
+		},
+	}
+}
+
 func (r *UserPermissionsResource) Configure(_ context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
 	if req.ProviderData == nil {
 		return
@@ -276,6 +289,7 @@ func (r *UserPermissionsResource) Create(ctx context.Context, req resource.Creat
 
 	diags = resp.State.Set(ctx, plan)
 	resp.Diagnostics.Append(diags...)
+	setIdentity(ctx, resp.State, resp.Identity, &resp.Diagnostics)
 }
 
 func (r *UserPermissionsResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
@@ -333,6 +347,7 @@ func (r *UserPermissionsResource) Read(ctx context.Context, req resource.ReadReq
 
 	diags = resp.State.Set(ctx, state)
 	resp.Diagnostics.Append(diags...)
+	setIdentity(ctx, resp.State, resp.Identity, &resp.Diagnostics)
 }
 
 func (r *UserPermissionsResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
@@ -444,6 +459,7 @@ func (r *UserPermissionsResource) Update(ctx context.Context, req resource.Updat
 
 	diags = resp.State.Set(ctx, plan)
 	resp.Diagnostics.Append(diags...)
+	setIdentity(ctx, resp.State, resp.Identity, &resp.Diagnostics)
 }
 
 func (r *UserPermissionsResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
@@ -486,6 +502,10 @@ func (r *UserPermissionsResource) Delete(ctx context.Context, req resource.Delet
 
 func (r *UserPermissionsResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
 	// Import using member_id
-	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("id"), req.ID)...)
-	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("member_id"), req.ID)...)
+	memberID := req.ID
+	if importStateFromIdentity(ctx, req, resp) {
+		resp.Diagnostics.Append(req.Identity.GetAttribute(ctx, path.Root("member_id"), &memberID)...)
+	}
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("id"), memberID)...)
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("member_id"), memberID)...)
 }
@@ -20,6 +20,8 @@ import (
 
 var _ resource.Resource = &VolumeBackupResource{}
 var _ resource.ResourceWithImportState = &VolumeBackupResource{}
+var _ resource.ResourceWithIdentity = &VolumeBackupResource{}
+var _ resource.ResourceWithModifyPlan = &VolumeBackupResource{}
 
 func NewVolumeBackupResource() resource.Resource {
 	return &VolumeBackupResource{}
@@ -104,7 +106,7 @@ func (r *VolumeBackupResource) Schema(_ context.Context, _ resource.SchemaReques
 			},
 			"service_name": schema.StringAttribute{
 				Optional:    true,
-				Description: "Service name within a compose stack. Required when service_type is 'compose'.",
+				Description: "Service name within a compose stack. Required when service_type is 'compose'. Checked against the services of the stack: an unknown service is a warning at plan time, as the compose file may change in the same apply, and an error at apply time.",
 			},
 			"turn_off": schema.BoolAttribute{
 				Optional:    true,
@@ -135,6 +137,10 @@ func (r *VolumeBackupResource) Schema(_ context.Context, _ resource.SchemaReques
 	}
 }
 
+func (r *VolumeBackupResource) IdentitySchema(_ context.Context, _ resource.IdentitySchemaRequest, resp *resource.IdentitySchemaResponse) {
+	resp.IdentitySchema = idIdentitySchema()
+}
+
 func (r *VolumeBackupResource) Configure(_ context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
 	if req.ProviderData == nil {
 		return
@@ -147,6 +153,29 @@ func (r *VolumeBackupResource) Configure(_ context.Context, req resource.Configu
 	r.client = client
 }
 
+func (r *VolumeBackupResource) ModifyPlan(ctx context.Context, req resource.ModifyPlanRequest, resp *resource.ModifyPlanResponse) {
+	// Resource is being destroyed.
+	if req.Plan.Raw.IsNull() || r.client == nil {
+		return
+	}
+
+	var plan, state VolumeBackupResourceModel
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	if !req.State.Raw.IsNull() {
+		resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	}
+	if resp.Diagnostics.HasError() || plan.ServiceType.ValueString() != "compose" {
+		return
+	}
+
+	// Check the compose service exists when it changes.
+	if plan.ServiceID.IsNull() || plan.ServiceID.IsUnknown() || plan.ServiceName.IsNull() || plan.ServiceName.IsUnknown() ||
+		(plan.ServiceID.Equal(state.ServiceID) && plan.ServiceName.Equal(state.ServiceName)) {
+		return
+	}
+	checkComposeServiceName(r.client, plan.ServiceID.ValueString(), plan.ServiceName.ValueString(), false, path.Root("service_name"), &resp.Diagnostics)
+}
+
 func (r *VolumeBackupResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
 	var plan VolumeBackupResourceModel
 	diags := req.Plan.Get(ctx, &plan)
@@ -163,6 +192,12 @@ func (r *VolumeBackupResource) Create(ctx context.Context, req resource.CreateRe
 		)
 		return
 	}
+	if plan.ServiceType.ValueString() == "compose" {
+		checkComposeServiceNameOnApply(ctx, r.client, plan.ServiceID, plan.ServiceName, nil, &resp.Diagnostics)
+		if resp.Diagnostics.HasError() {
+			return
+		}
+	}
 
 	backup := client.VolumeBackup{
 		Name:            plan.Name.ValueString(),
@@ -219,6 +254,7 @@ func (r *VolumeBackupResource) Create(ctx context.Context, req resource.CreateRe
 
 	diags = resp.State.Set(ctx, plan)
 	resp.Diagnostics.Append(diags...)
+	setIdentity(ctx, resp.State, resp.Identity, &resp.Diagnostics)
 }
 
 func (r *VolumeBackupResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
@@ -291,6 +327,7 @@ func (r *VolumeBackupResource) Read(ctx context.Context, req resource.ReadReques
 
 	diags = resp.State.Set(ctx, state)
 	resp.Diagnostics.Append(diags...)
+	setIdentity(ctx, resp.State, resp.Identity, &resp.Diagnostics)
 }
 
 func (r *VolumeBackupResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
@@ -308,6 +345,13 @@ func (r *VolumeBackupResource) Update(ctx context.Context, req resource.UpdateRe
 		return
 	}
 
+	if plan.ServiceType.ValueString() == "compose" {
+		checkComposeServiceNameOnApply(ctx, r.client, plan.ServiceID, plan.ServiceName, &req.State, &resp.Diagnostics)
+		if resp.Diagnostics.HasError() {
+			return
+		}
+	}
+
 	backup := client.VolumeBackup{
 		VolumeBackupID:  state.ID.ValueString(),
 		Name:            plan.Name.ValueString(),
@@ -347,6 +391,7 @@ func (r *VolumeBackupResource) Update(ctx context.Context, req resource.UpdateRe
 
 	diags = resp.State.Set(ctx, plan)
 	resp.Diagnostics.Append(diags...)
+	setIdentity(ctx, resp.State, resp.Identity, &resp.Diagnostics)
 }
 
 func (r *VolumeBackupResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
@@ -368,5 +413,5 @@ func (r *VolumeBackupResource) Delete(ctx context.Context, req resource.DeleteRe
 }
 
 func (r *VolumeBackupResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
-	resource.ImportStatePassthroughID(ctx, path.Root("id"), req, resp)
+	resource.ImportStatePassthroughWithIdentity(ctx, path.Root("id"), path.Root("id"), req, resp)
 }
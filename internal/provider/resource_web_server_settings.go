@@ -0,0 +1,281 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/ahmedali6/terraform-provider-dokploy/internal/client"
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/boolplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+// webServerSettingsID is the fixed ID of the singleton web server settings.
+const webServerSettingsID = "web-server"
+
+var _ resource.Resource = &WebServerSettingsResource{}
+var _ resource.ResourceWithImportState = &WebServerSettingsResource{}
+var _ resource.ResourceWithIdentity = &WebServerSettingsResource{}
+
+func NewWebServerSettingsResource() resource.Resource {
+	return &WebServerSettingsResource{}
+}
+
+type WebServerSettingsResource struct {
+	client *client.DokployClient
+}
+
+type WebServerSettingsResourceModel struct {
+	HostSettingsModel
+	ID               types.String `tfsdk:"id"`
+	Host             types.String `tfsdk:"host"`
+	HTTPS            types.Bool   `tfsdk:"https"`
+	CertificateType  types.String `tfsdk:"certificate_type"`
+	LetsEncryptEmail types.String `tfsdk:"lets_encrypt_email"`
+	LogCleanupCron   types.String `tfsdk:"log_cleanup_cron"`
+}
+
+func (r *WebServerSettingsResource) Metadata(_ context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_web_server_settings"
+}
+
+func (r *WebServerSettingsResource) Schema(_ context.Context, _ resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Description: "Manages the settings of the Dokploy host: the domain of the Dokploy UI, Let's Encrypt, Traefik configuration and dashboard, Docker cleanup and log rotation. " +
+			"There is a single instance of these settings; destroying the resource leaves the current settings in place.",
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				Computed:    true,
+				Description: "Always 'web-server'.",
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"host": schema.StringAttribute{
+				Optional:    true,
+				Computed:    true,
+				Description: "Domain the Dokploy UI is served on.",
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"https": schema.BoolAttribute{
+				Optional:    true,
+				Computed:    true,
+				Description: "Whether the Dokploy UI is served over HTTPS.",
+				PlanModifiers: []planmodifier.Bool{
+					boolplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"certificate_type": schema.StringAttribute{
+				Optional:    true,
+				Computed:    true,
+				Description: "Certificate provider for the Dokploy UI: none or letsencrypt.",
+				Validators: []validator.String{
+					stringvalidator.OneOf("none", "letsencrypt"),
+				},
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"lets_encrypt_email": schema.StringAttribute{
+				Optional:    true,
+				Computed:    true,
+				Description: "Email address used for Let's Encrypt registration.",
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"log_cleanup_cron": schema.StringAttribute{
+				Optional:    true,
+				Computed:    true,
+				Description: "Cron schedule for rotating the Traefik access logs (e.g., '0 0 * * *'). Set to an empty string to disable log rotation; empty when it is disabled.",
+				Validators: []validator.String{
+					stringvalidator.Any(
+						stringvalidator.OneOf(""),
+						cronExpressionValidator(),
+					),
+				},
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+		},
+	}
+	for name, attr := range hostSettingsSchemaAttributes() {
+		resp.Schema.Attributes[name] = attr
+	}
+}
+
+func (r *WebServerSettingsResource) IdentitySchema(_ context.Context, _ resource.IdentitySchemaRequest, resp *resource.IdentitySchemaResponse) {
+	resp.IdentitySchema = idIdentitySchema()
+}
+
+func (r *WebServerSettingsResource) Configure(_ context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+	client, ok := req.ProviderData.(*client.DokployClient)
+	if !ok {
+		resp.Diagnostics.AddError("Unexpected Resource Configure Type", fmt.Sprintf("Expected *client.DokployClient, got: %T", req.ProviderData))
+		return
+	}
+	r.client = client
+}
+
+// apply pushes the configured settings that differ from prior. prior is nil
+// on create.
+func (r *WebServerSettingsResource) apply(plan *WebServerSettingsResourceModel, prior *WebServerSettingsResourceModel) error {
+	if prior == nil {
+		prior = &WebServerSettingsResourceModel{}
+	}
+	if err := plan.HostSettingsModel.apply(r.client, "", &prior.HostSettingsModel); err != nil {
+		return err
+	}
+
+	domainChanged := settingChanged(plan.Host, prior.Host) ||
+		settingChanged(plan.HTTPS, prior.HTTPS) ||
+		settingChanged(plan.CertificateType, prior.CertificateType) ||
+		settingChanged(plan.LetsEncryptEmail, prior.LetsEncryptEmail)
+	if domainChanged {
+		// The domain settings are updated together, so start from the current
+		// values for those that are not configured.
+		settings, err := r.client.GetWebServerSettings()
+		if err != nil {
+			return err
+		}
+		if !plan.Host.IsUnknown() {
+			settings.Host = plan.Host.ValueString()
+		}
+		if !plan.HTTPS.IsUnknown() {
+			settings.HTTPS = plan.HTTPS.ValueBool()
+		}
+		if !plan.CertificateType.IsUnknown() {
+			settings.CertificateType = plan.CertificateType.ValueString()
+		}
+		if !plan.LetsEncryptEmail.IsUnknown() {
+			settings.LetsEncryptEmail = plan.LetsEncryptEmail.ValueString()
+		}
+		if err := r.client.AssignWebServerDomain(*settings); err != nil {
+			return err
+		}
+	}
+
+	if settingChanged(plan.LogCleanupCron, prior.LogCleanupCron) {
+		if err := r.client.UpdateLogCleanup(plan.LogCleanupCron.ValueString()); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// read refreshes all settings from the Dokploy host.
+func (r *WebServerSettingsResource) read(state *WebServerSettingsResourceModel) error {
+	if err := state.HostSettingsModel.read(r.client, ""); err != nil {
+		return err
+	}
+
+	settings, err := r.client.GetWebServerSettings()
+	if err != nil {
+		return err
+	}
+
+	state.ID = types.StringValue(webServerSettingsID)
+	state.Host = types.StringValue(settings.Host)
+	state.HTTPS = types.BoolValue(settings.HTTPS)
+	state.CertificateType = types.StringValue(settings.CertificateType)
+	state.LetsEncryptEmail = types.StringValue(settings.LetsEncryptEmail)
+	// Disabled log rotation reads as "" rather than null, so that it can be
+	// configured and stays in state when the attribute is removed.
+	state.LogCleanupCron = types.StringValue("")
+	if settings.LogCleanupCron != nil {
+		state.LogCleanupCron = types.StringValue(*settings.LogCleanupCron)
+	}
+	state.EnableDockerCleanup = types.BoolValue(settings.EnableDockerCleanup)
+	return nil
+}
+
+func (r *WebServerSettingsResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	var plan WebServerSettingsResourceModel
+	diags := req.Plan.Get(ctx, &plan)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	if err := r.apply(&plan, nil); err != nil {
+		resp.Diagnostics.AddError("Error updating web server settings", err.Error())
+		return
+	}
+
+	if err := r.read(&plan); err != nil {
+		resp.Diagnostics.AddError("Error reading web server settings", err.Error())
+		return
+	}
+
+	diags = resp.State.Set(ctx, plan)
+	resp.Diagnostics.Append(diags...)
+	setIdentity(ctx, resp.State, resp.Identity, &resp.Diagnostics)
+}
+
+func (r *WebServerSettingsResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	var state WebServerSettingsResourceModel
+	diags := req.State.Get(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	if err := r.read(&state); err != nil {
+		resp.Diagnostics.AddError("Error reading web server settings", err.Error())
+		return
+	}
+
+	diags = resp.State.Set(ctx, state)
+	resp.Diagnostics.Append(diags...)
+	setIdentity(ctx, resp.State, resp.Identity, &resp.Diagnostics)
+}
+
+func (r *WebServerSettingsResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	var plan WebServerSettingsResourceModel
+	diags := req.Plan.Get(ctx, &plan)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	var state WebServerSettingsResourceModel
+	diags = req.State.Get(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	if err := r.apply(&plan, &state); err != nil {
+		resp.Diagnostics.AddError("Error updating web server settings", err.Error())
+		return
+	}
+
+	if err := r.read(&plan); err != nil {
+		resp.Diagnostics.AddError("Error reading web server settings", err.Error())
+		return
+	}
+
+	diags = resp.State.Set(ctx, plan)
+	resp.Diagnostics.Append(diags...)
+	setIdentity(ctx, resp.State, resp.Identity, &resp.Diagnostics)
+}
+
+func (r *WebServerSettingsResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	// The settings cannot be deleted; they are only removed from state.
+}
+
+func (r *WebServerSettingsResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	resource.ImportStatePassthroughWithIdentity(ctx, path.Root("id"), path.Root("id"), req, resp)
+}
@@ -0,0 +1,67 @@
+package provider
+
+import (
+	"fmt"
+	"os"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+)
+
+func TestAccWebServerSettingsResource(t *testing.T) {
+	if os.Getenv("DOKPLOY_HOST") == "" || os.Getenv("DOKPLOY_API_KEY") == "" {
+		t.Skip("DOKPLOY_HOST and DOKPLOY_API_KEY must be set for acceptance tests")
+	}
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			// Create and Read testing
+			{
+				Config: testAccWebServerSettingsResourceConfig(true, "0 0 * * *"),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("dokploy_web_server_settings.test", "id", "web-server"),
+					resource.TestCheckResourceAttr("dokploy_web_server_settings.test", "enable_docker_cleanup", "true"),
+					resource.TestCheckResourceAttr("dokploy_web_server_settings.test", "log_cleanup_cron", "0 0 * * *"),
+					resource.TestCheckResourceAttrSet("dokploy_web_server_settings.test", "traefik_config"),
+					resource.TestCheckResourceAttrSet("dokploy_web_server_settings.test", "traefik_dashboard"),
+				),
+			},
+			// Update and Read testing
+			{
+				Config: testAccWebServerSettingsResourceConfig(false, "0 3 * * 0"),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("dokploy_web_server_settings.test", "enable_docker_cleanup", "false"),
+					resource.TestCheckResourceAttr("dokploy_web_server_settings.test", "log_cleanup_cron", "0 3 * * 0"),
+				),
+			},
+			// Disable log rotation
+			{
+				Config: testAccWebServerSettingsResourceConfig(false, ""),
+				Check:  resource.TestCheckResourceAttr("dokploy_web_server_settings.test", "log_cleanup_cron", ""),
+			},
+			// ImportState testing
+			{
+				ResourceName:      "dokploy_web_server_settings.test",
+				ImportState:       true,
+				ImportStateId:     "web-server",
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func testAccWebServerSettingsResourceConfig(enableDockerCleanup bool, logCleanupCron string) string {
+	return fmt.Sprintf(`
+provider "dokploy" {
+  host    = "%s"
+  api_key = "%s"
+}
+
+resource "dokploy_web_server_settings" "test" {
+  enable_docker_cleanup = %t
+  log_cleanup_cron      = %q
+}
+`, os.Getenv("DOKPLOY_HOST"), os.Getenv("DOKPLOY_API_KEY"), enableDockerCleanup, logCleanupCron)
+}
@@ -0,0 +1,724 @@
+package provider
+
+import (
+	"encoding/json"
+
+	"github.com/ahmedali6/terraform-provider-dokploy/internal/client"
+	"github.com/hashicorp/terraform-plugin-framework-validators/float64validator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/int64validator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/listvalidator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/mapvalidator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+// Models of the Docker Swarm service settings. Durations are in nanoseconds,
+// as in the Docker Engine API.
+
+type SwarmHealthCheckModel struct {
+	Test        []types.String `tfsdk:"test"`
+	Interval    types.Int64    `tfsdk:"interval"`
+	Timeout     types.Int64    `tfsdk:"timeout"`
+	StartPeriod types.Int64    `tfsdk:"start_period"`
+	Retries     types.Int64    `tfsdk:"retries"`
+}
+
+type SwarmRestartPolicyModel struct {
+	Condition   types.String `tfsdk:"condition"`
+	Delay       types.Int64  `tfsdk:"delay"`
+	MaxAttempts types.Int64  `tfsdk:"max_attempts"`
+	Window      types.Int64  `tfsdk:"window"`
+}
+
+type SwarmPlacementModel struct {
+	Constraints []types.String                  `tfsdk:"constraints"`
+	Preferences []SwarmPlacementPreferenceModel `tfsdk:"preferences"`
+	MaxReplicas types.Int64                     `tfsdk:"max_replicas"`
+	Platforms   []SwarmPlatformModel            `tfsdk:"platforms"`
+}
+
+type SwarmPlacementPreferenceModel struct {
+	Spread types.String `tfsdk:"spread"`
+}
+
+type SwarmPlatformModel struct {
+	Architecture types.String `tfsdk:"architecture"`
+	OS           types.String `tfsdk:"os"`
+}
+
+type SwarmUpdateConfigModel struct {
+	Parallelism     types.Int64   `tfsdk:"parallelism"`
+	Delay           types.Int64   `tfsdk:"delay"`
+	FailureAction   types.String  `tfsdk:"failure_action"`
+	Monitor         types.Int64   `tfsdk:"monitor"`
+	MaxFailureRatio types.Float64 `tfsdk:"max_failure_ratio"`
+	Order           types.String  `tfsdk:"order"`
+}
+
+type SwarmModeModel struct {
+	Mode     types.String `tfsdk:"mode"`
+	Replicas types.Int64  `tfsdk:"replicas"`
+}
+
+type SwarmNetworkModel struct {
+	Target     types.String            `tfsdk:"target"`
+	Aliases    []types.String          `tfsdk:"aliases"`
+	DriverOpts map[string]types.String `tfsdk:"driver_opts"`
+}
+
+type SwarmEndpointSpecModel struct {
+	Mode  types.String             `tfsdk:"mode"`
+	Ports []SwarmEndpointPortModel `tfsdk:"ports"`
+}
+
+type SwarmEndpointPortModel struct {
+	Protocol      types.String `tfsdk:"protocol"`
+	TargetPort    types.Int64  `tfsdk:"target_port"`
+	PublishedPort types.Int64  `tfsdk:"published_port"`
+	PublishMode   types.String `tfsdk:"publish_mode"`
+}
+
+// swarmSchemaAttributes returns the Docker Swarm attributes of the
+// application resource.
+func swarmSchemaAttributes() map[string]schema.Attribute {
+	duration := func(description string) schema.Int64Attribute {
+		return schema.Int64Attribute{
+			Optional:    true,
+			Description: description + " in nanoseconds.",
+			Validators: []validator.Int64{
+				int64validator.AtLeast(0),
+			},
+		}
+	}
+	updateConfig := func(description string, failureActions ...string) schema.SingleNestedAttribute {
+		return schema.SingleNestedAttribute{
+			Optional:    true,
+			Description: description,
+			Attributes: map[string]schema.Attribute{
+				"parallelism": schema.Int64Attribute{
+					Required:    true,
+					Description: "Maximum number of tasks updated at the same time. 0 updates all tasks at once.",
+					Validators: []validator.Int64{
+						int64validator.AtLeast(0),
+					},
+				},
+				"delay": duration("Delay between updates of task batches"),
+				"failure_action": schema.StringAttribute{
+					Optional:    true,
+					Description: "Action when a task fails to update.",
+					Validators: []validator.String{
+						stringvalidator.OneOf(failureActions...),
+					},
+				},
+				"monitor": duration("Time to monitor each task for failure after it is updated"),
+				"max_failure_ratio": schema.Float64Attribute{
+					Optional:    true,
+					Description: "Fraction of tasks that may fail before the failure action is triggered, between 0 and 1.",
+					Validators: []validator.Float64{
+						float64validator.Between(0, 1),
+					},
+				},
+				"order": schema.StringAttribute{
+					Optional:    true,
+					Description: "Order of operations when replacing a task: stop-first or start-first.",
+					Validators: []validator.String{
+						stringvalidator.OneOf("stop-first", "start-first"),
+					},
+				},
+			},
+		}
+	}
+
+	return map[string]schema.Attribute{
+		"health_check_swarm": schema.SingleNestedAttribute{
+			Optional:    true,
+			Description: "Health check of the Docker Swarm service.",
+			Attributes: map[string]schema.Attribute{
+				"test": schema.ListAttribute{
+					Optional:    true,
+					ElementType: types.StringType,
+					Description: "Health check command, e.g. [\"CMD\", \"curl\", \"-f\", \"http://localhost/\"] or [\"NONE\"] to disable the image health check.",
+					Validators: []validator.List{
+						listvalidator.SizeAtLeast(1),
+					},
+				},
+				"interval":     duration("Time between health checks"),
+				"timeout":      duration("Time after which a health check is considered hung"),
+				"start_period": duration("Initialization time before failed health checks count towards retries"),
+				"retries": schema.Int64Attribute{
+					Optional:    true,
+					Description: "Number of consecutive failures before the container is unhealthy.",
+					Validators: []validator.Int64{
+						int64validator.AtLeast(0),
+					},
+				},
+			},
+		},
+		"restart_policy_swarm": schema.SingleNestedAttribute{
+			Optional:    true,
+			Description: "Restart policy of the Docker Swarm service tasks.",
+			Attributes: map[string]schema.Attribute{
+				"condition": schema.StringAttribute{
+					Optional:    true,
+					Description: "When to restart tasks: none, on-failure or any.",
+					Validators: []validator.String{
+						stringvalidator.OneOf("none", "on-failure", "any"),
+					},
+				},
+				"delay": duration("Delay between restart attempts"),
+				"max_attempts": schema.Int64Attribute{
+					Optional:    true,
+					Description: "Maximum number of restart attempts. 0 means unlimited.",
+					Validators: []validator.Int64{
+						int64validator.AtLeast(0),
+					},
+				},
+				"window": duration("Window used to evaluate the restart policy"),
+			},
+		},
+		"placement_swarm": schema.SingleNestedAttribute{
+			Optional:    true,
+			Description: "Placement of the Docker Swarm service tasks.",
+			Attributes: map[string]schema.Attribute{
+				"constraints": schema.ListAttribute{
+					Optional:    true,
+					ElementType: types.StringType,
+					Description: "Placement constraints, e.g. \"node.role == worker\".",
+					Validators: []validator.List{
+						listvalidator.SizeAtLeast(1),
+					},
+				},
+				"preferences": schema.ListNestedAttribute{
+					Optional:    true,
+					Description: "Placement preferences.",
+					Validators: []validator.List{
+						listvalidator.SizeAtLeast(1),
+					},
+					NestedObject: schema.NestedAttributeObject{
+						Attributes: map[string]schema.Attribute{
+							"spread": schema.StringAttribute{
+								Required:    true,
+								Description: "Label descriptor to spread tasks over, e.g. \"node.labels.zone\".",
+							},
+						},
+					},
+				},
+				"max_replicas": schema.Int64Attribute{
+					Optional:    true,
+					Description: "Maximum number of tasks per node. 0 means unlimited.",
+					Validators: []validator.Int64{
+						int64validator.AtLeast(0),
+					},
+				},
+				"platforms": schema.ListNestedAttribute{
+					Optional:    true,
+					Description: "Platforms the tasks can run on.",
+					Validators: []validator.List{
+						listvalidator.SizeAtLeast(1),
+					},
+					NestedObject: schema.NestedAttributeObject{
+						Attributes: map[string]schema.Attribute{
+							"architecture": schema.StringAttribute{
+								Required:    true,
+								Description: "CPU architecture, e.g. amd64 or arm64.",
+							},
+							"os": schema.StringAttribute{
+								Required:    true,
+								Description: "Operating system, e.g. linux.",
+							},
+						},
+					},
+				},
+			},
+		},
+		"update_config_swarm":   updateConfig("Rolling update configuration of the Docker Swarm service.", "continue", "pause", "rollback"),
+		"rollback_config_swarm": updateConfig("Rollback configuration of the Docker Swarm service.", "continue", "pause"),
+		"mode_swarm": schema.SingleNestedAttribute{
+			Optional:    true,
+			Description: "Scheduling mode of the Docker Swarm service.",
+			Attributes: map[string]schema.Attribute{
+				"mode": schema.StringAttribute{
+					Required:    true,
+					Description: "Service mode: replicated or global.",
+					Validators: []validator.String{
+						stringvalidator.OneOf("replicated", "global"),
+					},
+				},
+				"replicas": schema.Int64Attribute{
+					Optional:    true,
+					Description: "Number of tasks in replicated mode.",
+					Validators: []validator.Int64{
+						int64validator.AtLeast(0),
+					},
+				},
+			},
+		},
+		"labels_swarm": schema.MapAttribute{
+			Optional:    true,
+			ElementType: types.StringType,
+			Description: "Labels of the Docker Swarm service.",
+		},
+		"network_swarm": schema.ListNestedAttribute{
+			Optional:    true,
+			Description: "Networks the Docker Swarm service is attached to.",
+			NestedObject: schema.NestedAttributeObject{
+				Attributes: map[string]schema.Attribute{
+					"target": schema.StringAttribute{
+						Required:    true,
+						Description: "Name or ID of the network.",
+					},
+					"aliases": schema.ListAttribute{
+						Optional:    true,
+						ElementType: types.StringType,
+						Description: "Network aliases of the service.",
+						Validators: []validator.List{
+							listvalidator.SizeAtLeast(1),
+						},
+					},
+					"driver_opts": schema.MapAttribute{
+						Optional:    true,
+						ElementType: types.StringType,
+						Description: "Driver options of the attachment.",
+						Validators: []validator.Map{
+							mapvalidator.SizeAtLeast(1),
+						},
+					},
+				},
+			},
+		},
+		"stop_grace_period_swarm": duration("Time to wait for a task to stop before killing it"),
+		"endpoint_spec_swarm": schema.SingleNestedAttribute{
+			Optional:    true,
+			Description: "Endpoint specification of the Docker Swarm service.",
+			Attributes: map[string]schema.Attribute{
+				"mode": schema.StringAttribute{
+					Optional:    true,
+					Description: "Resolution mode: vip or dnsrr.",
+					Validators: []validator.String{
+						stringvalidator.OneOf("vip", "dnsrr"),
+					},
+				},
+				"ports": schema.ListNestedAttribute{
+					Optional:    true,
+					Description: "Published ports.",
+					Validators: []validator.List{
+						listvalidator.SizeAtLeast(1),
+					},
+					NestedObject: schema.NestedAttributeObject{
+						Attributes: map[string]schema.Attribute{
+							"protocol": schema.StringAttribute{
+								Optional:    true,
+								Description: "Protocol: tcp, udp or sctp.",
+								Validators: []validator.String{
+									stringvalidator.OneOf("tcp", "udp", "sctp"),
+								},
+							},
+							"target_port": schema.Int64Attribute{
+								Required:    true,
+								Description: "Port inside the container.",
+								Validators: []validator.Int64{
+									int64validator.Between(1, 65535),
+								},
+							},
+							"published_port": schema.Int64Attribute{
+								Optional:    true,
+								Description: "Port published on the swarm nodes.",
+								Validators: []validator.Int64{
+									int64validator.Between(1, 65535),
+								},
+							},
+							"publish_mode": schema.StringAttribute{
+								Optional:    true,
+								Description: "Publish mode: ingress or host.",
+								Validators: []validator.String{
+									stringvalidator.OneOf("ingress", "host"),
+								},
+							},
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+// The Docker Engine API specs the models serialize to. Pointer fields are
+// omitted when null so that unset values keep the Docker defaults. Empty
+// collections are omitted as well, which is why the schema requires at least
+// one element in the collections nested in these specs.
+
+type swarmHealthCheckSpec struct {
+	Test        []string `json:"Test,omitempty"`
+	Interval    *int64   `json:"Interval,omitempty"`
+	Timeout     *int64   `json:"Timeout,omitempty"`
+	StartPeriod *int64   `json:"StartPeriod,omitempty"`
+	Retries     *int64   `json:"Retries,omitempty"`
+}
+
+type swarmRestartPolicySpec struct {
+	Condition   *string `json:"Condition,omitempty"`
+	Delay       *int64  `json:"Delay,omitempty"`
+	MaxAttempts *int64  `json:"MaxAttempts,omitempty"`
+	Window      *int64  `json:"Window,omitempty"`
+}
+
+type swarmPlacementSpec struct {
+	Constraints []string                   `json:"Constraints,omitempty"`
+	Preferences []swarmPlacementPreference `json:"Preferences,omitempty"`
+	MaxReplicas *int64                     `json:"MaxReplicas,omitempty"`
+	Platforms   []swarmPlatformSpec        `json:"Platforms,omitempty"`
+}
+
+type swarmPlacementPreference struct {
+	Spread struct {
+		SpreadDescriptor string `json:"SpreadDescriptor"`
+	} `json:"Spread"`
+}
+
+type swarmPlatformSpec struct {
+	Architecture string `json:"Architecture"`
+	OS           string `json:"OS"`
+}
+
+type swarmUpdateConfigSpec struct {
+	Parallelism     int64    `json:"Parallelism"`
+	Delay           *int64   `json:"Delay,omitempty"`
+	FailureAction   *string  `json:"FailureAction,omitempty"`
+	Monitor         *int64   `json:"Monitor,omitempty"`
+	MaxFailureRatio *float64 `json:"MaxFailureRatio,omitempty"`
+	Order           *string  `json:"Order,omitempty"`
+}
+
+type swarmModeSpec struct {
+	Replicated *swarmReplicatedSpec `json:"Replicated,omitempty"`
+	Global     *struct{}            `json:"Global,omitempty"`
+}
+
+type swarmReplicatedSpec struct {
+	Replicas *int64 `json:"Replicas,omitempty"`
+}
+
+type swarmNetworkSpec struct {
+	Target     string            `json:"Target"`
+	Aliases    []string          `json:"Aliases,omitempty"`
+	DriverOpts map[string]string `json:"DriverOpts,omitempty"`
+}
+
+type swarmEndpointSpec struct {
+	Mode  *string                 `json:"Mode,omitempty"`
+	Ports []swarmEndpointPortSpec `json:"Ports,omitempty"`
+}
+
+type swarmEndpointPortSpec struct {
+	Protocol      *string `json:"Protocol,omitempty"`
+	TargetPort    int64   `json:"TargetPort"`
+	PublishedPort *int64  `json:"PublishedPort,omitempty"`
+	PublishMode   *string `json:"PublishMode,omitempty"`
+}
+
+// applySwarmSettings serializes the Docker Swarm settings of the plan into
+// the application update.
+func applySwarmSettings(plan *ApplicationResourceModel, app *client.Application) error {
+	var err error
+	if m := plan.HealthCheckSwarm; m != nil {
+		spec := swarmHealthCheckSpec{
+			Test:        stringSlice(m.Test),
+			Interval:    m.Interval.ValueInt64Pointer(),
+			Timeout:     m.Timeout.ValueInt64Pointer(),
+			StartPeriod: m.StartPeriod.ValueInt64Pointer(),
+			Retries:     m.Retries.ValueInt64Pointer(),
+		}
+		if app.HealthCheckSwarm, err = toJSONMap(spec); err != nil {
+			return err
+		}
+	}
+	if m := plan.RestartPolicySwarm; m != nil {
+		spec := swarmRestartPolicySpec{
+			Condition:   m.Condition.ValueStringPointer(),
+			Delay:       m.Delay.ValueInt64Pointer(),
+			MaxAttempts: m.MaxAttempts.ValueInt64Pointer(),
+			Window:      m.Window.ValueInt64Pointer(),
+		}
+		if app.RestartPolicySwarm, err = toJSONMap(spec); err != nil {
+			return err
+		}
+	}
+	if m := plan.PlacementSwarm; m != nil {
+		spec := swarmPlacementSpec{
+			Constraints: stringSlice(m.Constraints),
+			MaxReplicas: m.MaxReplicas.ValueInt64Pointer(),
+		}
+		for _, p := range m.Preferences {
+			var preference swarmPlacementPreference
+			preference.Spread.SpreadDescriptor = p.Spread.ValueString()
+			spec.Preferences = append(spec.Preferences, preference)
+		}
+		for _, p := range m.Platforms {
+			spec.Platforms = append(spec.Platforms, swarmPlatformSpec{
+				Architecture: p.Architecture.ValueString(),
+				OS:           p.OS.ValueString(),
+			})
+		}
+		if app.PlacementSwarm, err = toJSONMap(spec); err != nil {
+			return err
+		}
+	}
+	if m := plan.UpdateConfigSwarm; m != nil {
+		if app.UpdateConfigSwarm, err = toJSONMap(m.spec()); err != nil {
+			return err
+		}
+	}
+	if m := plan.RollbackConfigSwarm; m != nil {
+		if app.RollbackConfigSwarm, err = toJSONMap(m.spec()); err != nil {
+			return err
+		}
+	}
+	if m := plan.ModeSwarm; m != nil {
+		var spec swarmModeSpec
+		if m.Mode.ValueString() == "global" {
+			spec.Global = &struct{}{}
+		} else {
+			spec.Replicated = &swarmReplicatedSpec{Replicas: m.Replicas.ValueInt64Pointer()}
+		}
+		if app.ModeSwarm, err = toJSONMap(spec); err != nil {
+			return err
+		}
+	}
+	if plan.LabelsSwarm != nil {
+		labels := make(map[string]interface{}, len(plan.LabelsSwarm))
+		for k, v := range plan.LabelsSwarm {
+			labels[k] = v.ValueString()
+		}
+		app.LabelsSwarm = labels
+	}
+	if plan.NetworkSwarm != nil {
+		app.NetworkSwarm = make([]map[string]interface{}, 0, len(plan.NetworkSwarm))
+		for _, n := range plan.NetworkSwarm {
+			spec := swarmNetworkSpec{
+				Target:  n.Target.ValueString(),
+				Aliases: stringSlice(n.Aliases),
+			}
+			if n.DriverOpts != nil {
+				spec.DriverOpts = make(map[string]string, len(n.DriverOpts))
+				for k, v := range n.DriverOpts {
+					spec.DriverOpts[k] = v.ValueString()
+				}
+			}
+			network, err := toJSONMap(spec)
+			if err != nil {
+				return err
+			}
+			app.NetworkSwarm = append(app.NetworkSwarm, network)
+		}
+	}
+	if m := plan.EndpointSpecSwarm; m != nil {
+		spec := swarmEndpointSpec{Mode: m.Mode.ValueStringPointer()}
+		for _, p := range m.Ports {
+			spec.Ports = append(spec.Ports, swarmEndpointPortSpec{
+				Protocol:      p.Protocol.ValueStringPointer(),
+				TargetPort:    p.TargetPort.ValueInt64(),
+				PublishedPort: p.PublishedPort.ValueInt64Pointer(),
+				PublishMode:   p.PublishMode.ValueStringPointer(),
+			})
+		}
+		if app.EndpointSpecSwarm, err = toJSONMap(spec); err != nil {
+			return err
+		}
+	}
+	if !plan.StopGracePeriodSwarm.IsUnknown() {
+		app.StopGracePeriodSwarm = plan.StopGracePeriodSwarm.ValueInt64Pointer()
+	}
+	return nil
+}
+
+func (m *SwarmUpdateConfigModel) spec() swarmUpdateConfigSpec {
+	return swarmUpdateConfigSpec{
+		Parallelism:     m.Parallelism.ValueInt64(),
+		Delay:           m.Delay.ValueInt64Pointer(),
+		FailureAction:   m.FailureAction.ValueStringPointer(),
+		Monitor:         m.Monitor.ValueInt64Pointer(),
+		MaxFailureRatio: m.MaxFailureRatio.ValueFloat64Pointer(),
+		Order:           m.Order.ValueStringPointer(),
+	}
+}
+
+// readSwarmSettings sets the Docker Swarm settings of the model from the
+// application. Settings that are not set on the application are set to
+// null, so that settings cleared outside of Terraform show up as drift.
+func readSwarmSettings(m *ApplicationResourceModel, app *client.Application) {
+	m.HealthCheckSwarm = nil
+	m.RestartPolicySwarm = nil
+	m.PlacementSwarm = nil
+	m.UpdateConfigSwarm = nil
+	m.RollbackConfigSwarm = nil
+	m.ModeSwarm = nil
+	m.LabelsSwarm = nil
+	m.NetworkSwarm = nil
+	m.EndpointSpecSwarm = nil
+	m.StopGracePeriodSwarm = types.Int64PointerValue(app.StopGracePeriodSwarm)
+
+	var healthCheck swarmHealthCheckSpec
+	if fromJSONMap(app.HealthCheckSwarm, &healthCheck) {
+		m.HealthCheckSwarm = &SwarmHealthCheckModel{
+			Test:        stringValues(healthCheck.Test),
+			Interval:    types.Int64PointerValue(healthCheck.Interval),
+			Timeout:     types.Int64PointerValue(healthCheck.Timeout),
+			StartPeriod: types.Int64PointerValue(healthCheck.StartPeriod),
+			Retries:     types.Int64PointerValue(healthCheck.Retries),
+		}
+	}
+
+	var restartPolicy swarmRestartPolicySpec
+	if fromJSONMap(app.RestartPolicySwarm, &restartPolicy) {
+		m.RestartPolicySwarm = &SwarmRestartPolicyModel{
+			Condition:   types.StringPointerValue(restartPolicy.Condition),
+			Delay:       types.Int64PointerValue(restartPolicy.Delay),
+			MaxAttempts: types.Int64PointerValue(restartPolicy.MaxAttempts),
+			Window:      types.Int64PointerValue(restartPolicy.Window),
+		}
+	}
+
+	var placement swarmPlacementSpec
+	if fromJSONMap(app.PlacementSwarm, &placement) {
+		model := &SwarmPlacementModel{
+			Constraints: stringValues(placement.Constraints),
+			MaxReplicas: types.Int64PointerValue(placement.MaxReplicas),
+		}
+		for _, p := range placement.Preferences {
+			model.Preferences = append(model.Preferences, SwarmPlacementPreferenceModel{
+				Spread: types.StringValue(p.Spread.SpreadDescriptor),
+			})
+		}
+		for _, p := range placement.Platforms {
+			model.Platforms = append(model.Platforms, SwarmPlatformModel{
+				Architecture: types.StringValue(p.Architecture),
+				OS:           types.StringValue(p.OS),
+			})
+		}
+		m.PlacementSwarm = model
+	}
+
+	var updateConfig swarmUpdateConfigSpec
+	if fromJSONMap(app.UpdateConfigSwarm, &updateConfig) {
+		m.UpdateConfigSwarm = updateConfig.model()
+	}
+	var rollbackConfig swarmUpdateConfigSpec
+	if fromJSONMap(app.RollbackConfigSwarm, &rollbackConfig) {
+		m.RollbackConfigSwarm = rollbackConfig.model()
+	}
+
+	var mode swarmModeSpec
+	if fromJSONMap(app.ModeSwarm, &mode) {
+		switch {
+		case mode.Global != nil:
+			m.ModeSwarm = &SwarmModeModel{Mode: types.StringValue("global"), Replicas: types.Int64Null()}
+		case mode.Replicated != nil:
+			m.ModeSwarm = &SwarmModeModel{Mode: types.StringValue("replicated"), Replicas: types.Int64PointerValue(mode.Replicated.Replicas)}
+		}
+	}
+
+	if app.LabelsSwarm != nil {
+		m.LabelsSwarm = make(map[string]types.String, len(app.LabelsSwarm))
+		for k, v := range app.LabelsSwarm {
+			if s, ok := v.(string); ok {
+				m.LabelsSwarm[k] = types.StringValue(s)
+			}
+		}
+	}
+
+	if app.NetworkSwarm != nil {
+		m.NetworkSwarm = make([]SwarmNetworkModel, 0, len(app.NetworkSwarm))
+		for _, n := range app.NetworkSwarm {
+			var network swarmNetworkSpec
+			if !fromJSONMap(n, &network) {
+				continue
+			}
+			model := SwarmNetworkModel{
+				Target:  types.StringValue(network.Target),
+				Aliases: stringValues(network.Aliases),
+			}
+			if network.DriverOpts != nil {
+				model.DriverOpts = make(map[string]types.String, len(network.DriverOpts))
+				for k, v := range network.DriverOpts {
+					model.DriverOpts[k] = types.StringValue(v)
+				}
+			}
+			m.NetworkSwarm = append(m.NetworkSwarm, model)
+		}
+	}
+
+	var endpointSpec swarmEndpointSpec
+	if fromJSONMap(app.EndpointSpecSwarm, &endpointSpec) {
+		model := &SwarmEndpointSpecModel{Mode: types.StringPointerValue(endpointSpec.Mode)}
+		for _, p := range endpointSpec.Ports {
+			model.Ports = append(model.Ports, SwarmEndpointPortModel{
+				Protocol:      types.StringPointerValue(p.Protocol),
+				TargetPort:    types.Int64Value(p.TargetPort),
+				PublishedPort: types.Int64PointerValue(p.PublishedPort),
+				PublishMode:   types.StringPointerValue(p.PublishMode),
+			})
+		}
+		m.EndpointSpecSwarm = model
+	}
+}
+
+func (s swarmUpdateConfigSpec) model() *SwarmUpdateConfigModel {
+	return &SwarmUpdateConfigModel{
+		Parallelism:     types.Int64Value(s.Parallelism),
+		Delay:           types.Int64PointerValue(s.Delay),
+		FailureAction:   types.StringPointerValue(s.FailureAction),
+		Monitor:         types.Int64PointerValue(s.Monitor),
+		MaxFailureRatio: types.Float64PointerValue(s.MaxFailureRatio),
+		Order:           types.StringPointerValue(s.Order),
+	}
+}
+
+// toJSONMap converts a spec to the generic map the client sends.
+func toJSONMap(spec interface{}) (map[string]interface{}, error) {
+	data, err := json.Marshal(spec)
+	if err != nil {
+		return nil, err
+	}
+	var m map[string]interface{}
+	if err := json.Unmarshal(data, &m); err != nil {
+		return nil, err
+	}
+	return m, nil
+}
+
+// fromJSONMap converts a generic map returned by the client to a spec. It
+// reports false when the map is nil or does not match the spec.
+func fromJSONMap(m map[string]interface{}, spec interface{}) bool {
+	if m == nil {
+		return false
+	}
+	data, err := json.Marshal(m)
+	if err != nil {
+		return false
+	}
+	return json.Unmarshal(data, spec) == nil
+}
+
+func stringSlice(values []types.String) []string {
+	if values == nil {
+		return nil
+	}
+	out := make([]string, len(values))
+	for i, v := range values {
+		out[i] = v.ValueString()
+	}
+	return out
+}
+
+func stringValues(values []string) []types.String {
+	if values == nil {
+		return nil
+	}
+	out := make([]types.String, len(values))
+	for i, v := range values {
+		out[i] = types.StringValue(v)
+	}
+	return out
+}
@@ -0,0 +1,272 @@
+package provider
+
+import (
+	"context"
+	"encoding/json"
+	"reflect"
+	"testing"
+
+	"github.com/ahmedali6/terraform-provider-dokploy/internal/client"
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+func TestSwarmSettingsRoundTrip(t *testing.T) {
+	strs := func(values ...string) []types.String {
+		return stringValues(values)
+	}
+	updateConfig := &SwarmUpdateConfigModel{
+		Parallelism:     types.Int64Value(2),
+		Delay:           types.Int64Value(10000000000),
+		FailureAction:   types.StringValue("rollback"),
+		Monitor:         types.Int64Value(5000000000),
+		MaxFailureRatio: types.Float64Value(0.25),
+		Order:           types.StringValue("start-first"),
+	}
+
+	tests := []struct {
+		name string
+		plan ApplicationResourceModel
+	}{
+		{
+			name: "none",
+		},
+		{
+			name: "health check",
+			plan: ApplicationResourceModel{
+				HealthCheckSwarm: &SwarmHealthCheckModel{
+					Test:        strs("CMD", "curl", "-f", "http://localhost/"),
+					Interval:    types.Int64Value(30000000000),
+					Timeout:     types.Int64Value(5000000000),
+					StartPeriod: types.Int64Value(0),
+					Retries:     types.Int64Value(3),
+				},
+			},
+		},
+		{
+			name: "health check without test",
+			plan: ApplicationResourceModel{
+				HealthCheckSwarm: &SwarmHealthCheckModel{
+					Interval:    types.Int64Value(30000000000),
+					Timeout:     types.Int64Null(),
+					StartPeriod: types.Int64Null(),
+					Retries:     types.Int64Null(),
+				},
+			},
+		},
+		{
+			name: "restart policy",
+			plan: ApplicationResourceModel{
+				RestartPolicySwarm: &SwarmRestartPolicyModel{
+					Condition:   types.StringValue("on-failure"),
+					Delay:       types.Int64Value(5000000000),
+					MaxAttempts: types.Int64Value(0),
+					Window:      types.Int64Null(),
+				},
+			},
+		},
+		{
+			name: "placement",
+			plan: ApplicationResourceModel{
+				PlacementSwarm: &SwarmPlacementModel{
+					Constraints: strs("node.role == worker", "node.labels.disk == ssd"),
+					Preferences: []SwarmPlacementPreferenceModel{
+						{Spread: types.StringValue("node.labels.zone")},
+					},
+					MaxReplicas: types.Int64Value(1),
+					Platforms: []SwarmPlatformModel{
+						{Architecture: types.StringValue("amd64"), OS: types.StringValue("linux")},
+						{Architecture: types.StringValue("arm64"), OS: types.StringValue("linux")},
+					},
+				},
+			},
+		},
+		{
+			name: "placement with max replicas only",
+			plan: ApplicationResourceModel{
+				PlacementSwarm: &SwarmPlacementModel{
+					MaxReplicas: types.Int64Value(2),
+				},
+			},
+		},
+		{
+			name: "update and rollback config",
+			plan: ApplicationResourceModel{
+				UpdateConfigSwarm: updateConfig,
+				RollbackConfigSwarm: &SwarmUpdateConfigModel{
+					Parallelism:     types.Int64Value(0),
+					Delay:           types.Int64Null(),
+					FailureAction:   types.StringNull(),
+					Monitor:         types.Int64Null(),
+					MaxFailureRatio: types.Float64Null(),
+					Order:           types.StringNull(),
+				},
+			},
+		},
+		{
+			name: "replicated mode",
+			plan: ApplicationResourceModel{
+				ModeSwarm: &SwarmModeModel{Mode: types.StringValue("replicated"), Replicas: types.Int64Value(3)},
+			},
+		},
+		{
+			name: "global mode",
+			plan: ApplicationResourceModel{
+				ModeSwarm: &SwarmModeModel{Mode: types.StringValue("global"), Replicas: types.Int64Null()},
+			},
+		},
+		{
+			name: "labels",
+			plan: ApplicationResourceModel{
+				LabelsSwarm: map[string]types.String{
+					"com.example.team": types.StringValue("platform"),
+				},
+			},
+		},
+		{
+			name: "networks",
+			plan: ApplicationResourceModel{
+				NetworkSwarm: []SwarmNetworkModel{
+					{
+						Target:     types.StringValue("dokploy-network"),
+						Aliases:    strs("api", "api.internal"),
+						DriverOpts: map[string]types.String{"com.docker.network.driver.mtu": types.StringValue("1400")},
+					},
+					{
+						Target: types.StringValue("monitoring"),
+					},
+				},
+			},
+		},
+		{
+			name: "empty labels and networks",
+			plan: ApplicationResourceModel{
+				LabelsSwarm:  map[string]types.String{},
+				NetworkSwarm: []SwarmNetworkModel{},
+			},
+		},
+		{
+			name: "endpoint spec",
+			plan: ApplicationResourceModel{
+				EndpointSpecSwarm: &SwarmEndpointSpecModel{
+					Mode: types.StringValue("vip"),
+					Ports: []SwarmEndpointPortModel{
+						{
+							Protocol:      types.StringValue("udp"),
+							TargetPort:    types.Int64Value(53),
+							PublishedPort: types.Int64Value(5353),
+							PublishMode:   types.StringValue("host"),
+						},
+						{
+							Protocol:      types.StringNull(),
+							TargetPort:    types.Int64Value(80),
+							PublishedPort: types.Int64Null(),
+							PublishMode:   types.StringNull(),
+						},
+					},
+				},
+			},
+		},
+		{
+			name: "stop grace period",
+			plan: ApplicationResourceModel{
+				StopGracePeriodSwarm: types.Int64Value(30000000000),
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			var app client.Application
+			if err := applySwarmSettings(&tt.plan, &app); err != nil {
+				t.Fatalf("applySwarmSettings: %v", err)
+			}
+
+			// Send the settings through JSON as the API does.
+			data, err := json.Marshal(app)
+			if err != nil {
+				t.Fatalf("marshal: %v", err)
+			}
+			var stored client.Application
+			if err := json.Unmarshal(data, &stored); err != nil {
+				t.Fatalf("unmarshal: %v", err)
+			}
+
+			// Start from stale settings to check that unset ones are cleared.
+			got := ApplicationResourceModel{
+				HealthCheckSwarm:     &SwarmHealthCheckModel{Retries: types.Int64Value(1)},
+				RestartPolicySwarm:   &SwarmRestartPolicyModel{Condition: types.StringValue("any")},
+				PlacementSwarm:       &SwarmPlacementModel{Constraints: strs("node.role == manager")},
+				UpdateConfigSwarm:    updateConfig,
+				RollbackConfigSwarm:  updateConfig,
+				ModeSwarm:            &SwarmModeModel{Mode: types.StringValue("global")},
+				LabelsSwarm:          map[string]types.String{"stale": types.StringValue("true")},
+				NetworkSwarm:         []SwarmNetworkModel{{Target: types.StringValue("stale")}},
+				StopGracePeriodSwarm: types.Int64Value(1),
+				EndpointSpecSwarm:    &SwarmEndpointSpecModel{Mode: types.StringValue("dnsrr")},
+			}
+			readSwarmSettings(&got, &stored)
+
+			if !reflect.DeepEqual(got, tt.plan) {
+				t.Errorf("round trip mismatch\n got: %+v\nwant: %+v", got, tt.plan)
+			}
+		})
+	}
+}
+
+func TestSwarmSchemaRejectsEmptyCollections(t *testing.T) {
+	attrs := swarmSchemaAttributes()
+	nested := func(name string) map[string]schema.Attribute {
+		switch a := attrs[name].(type) {
+		case schema.SingleNestedAttribute:
+			return a.Attributes
+		case schema.ListNestedAttribute:
+			return a.NestedObject.Attributes
+		}
+		t.Fatalf("%s is not a nested attribute", name)
+		return nil
+	}
+
+	tests := []struct {
+		name string
+		attr schema.Attribute
+	}{
+		{name: "health_check_swarm.test", attr: nested("health_check_swarm")["test"]},
+		{name: "placement_swarm.constraints", attr: nested("placement_swarm")["constraints"]},
+		{name: "placement_swarm.preferences", attr: nested("placement_swarm")["preferences"]},
+		{name: "placement_swarm.platforms", attr: nested("placement_swarm")["platforms"]},
+		{name: "network_swarm.aliases", attr: nested("network_swarm")["aliases"]},
+		{name: "network_swarm.driver_opts", attr: nested("network_swarm")["driver_opts"]},
+		{name: "endpoint_spec_swarm.ports", attr: nested("endpoint_spec_swarm")["ports"]},
+	}
+
+	ctx := context.Background()
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			var failed bool
+			switch a := tt.attr.(type) {
+			case interface{ ListValidators() []validator.List }:
+				empty := types.ListValueMust(types.StringType, []attr.Value{})
+				for _, v := range a.ListValidators() {
+					var resp validator.ListResponse
+					v.ValidateList(ctx, validator.ListRequest{ConfigValue: empty}, &resp)
+					failed = failed || resp.Diagnostics.HasError()
+				}
+			case interface{ MapValidators() []validator.Map }:
+				empty := types.MapValueMust(types.StringType, map[string]attr.Value{})
+				for _, v := range a.MapValidators() {
+					var resp validator.MapResponse
+					v.ValidateMap(ctx, validator.MapRequest{ConfigValue: empty}, &resp)
+					failed = failed || resp.Diagnostics.HasError()
+				}
+			default:
+				t.Fatalf("unexpected attribute type %T", tt.attr)
+			}
+			if !failed {
+				t.Error("empty collection accepted")
+			}
+		})
+	}
+}
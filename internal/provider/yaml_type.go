@@ -0,0 +1,139 @@
+package provider
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"io"
+	"reflect"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/attr/xattr"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/types/basetypes"
+	"github.com/hashicorp/terraform-plugin-go/tftypes"
+	"gopkg.in/yaml.v3"
+)
+
+var _ basetypes.StringTypable = YAMLType{}
+var _ basetypes.StringValuableWithSemanticEquals = YAMLValue{}
+var _ xattr.ValidateableAttribute = YAMLValue{}
+
+// YAMLType is a string type holding a YAML document. Values that decode to
+// the same data are semantically equal, so key order, indentation, quoting
+// and comments do not produce diffs.
+type YAMLType struct {
+	basetypes.StringType
+}
+
+func (t YAMLType) String() string {
+	return "YAMLType"
+}
+
+func (t YAMLType) ValueType(_ context.Context) attr.Value {
+	return YAMLValue{}
+}
+
+func (t YAMLType) Equal(o attr.Type) bool {
+	other, ok := o.(YAMLType)
+	if !ok {
+		return false
+	}
+	return t.StringType.Equal(other.StringType)
+}
+
+func (t YAMLType) ValueFromString(_ context.Context, in basetypes.StringValue) (basetypes.StringValuable, diag.Diagnostics) {
+	return YAMLValue{StringValue: in}, nil
+}
+
+func (t YAMLType) ValueFromTerraform(ctx context.Context, in tftypes.Value) (attr.Value, error) {
+	attrValue, err := t.StringType.ValueFromTerraform(ctx, in)
+	if err != nil {
+		return nil, err
+	}
+	stringValue, ok := attrValue.(basetypes.StringValue)
+	if !ok {
+		return nil, fmt.Errorf("unexpected value type of %T", attrValue)
+	}
+	return YAMLValue{StringValue: stringValue}, nil
+}
+
+// YAMLValue is a value of YAMLType.
+type YAMLValue struct {
+	basetypes.StringValue
+}
+
+func NewYAMLValue(value string) YAMLValue {
+	return YAMLValue{StringValue: basetypes.NewStringValue(value)}
+}
+
+func NewYAMLNull() YAMLValue {
+	return YAMLValue{StringValue: basetypes.NewStringNull()}
+}
+
+func (v YAMLValue) Type(_ context.Context) attr.Type {
+	return YAMLType{}
+}
+
+func (v YAMLValue) Equal(o attr.Value) bool {
+	other, ok := o.(YAMLValue)
+	if !ok {
+		return false
+	}
+	return v.StringValue.Equal(other.StringValue)
+}
+
+func (v YAMLValue) StringSemanticEquals(_ context.Context, newValuable basetypes.StringValuable) (bool, diag.Diagnostics) {
+	var diags diag.Diagnostics
+
+	newValue, ok := newValuable.(YAMLValue)
+	if !ok {
+		diags.AddError(
+			"Semantic Equality Check Error",
+			fmt.Sprintf("Expected value type %T, got: %T. Please report this issue to the provider developers.", v, newValuable),
+		)
+		return false, diags
+	}
+
+	// Invalid documents are compared as plain strings; validation reports them.
+	oldDocuments, err := decodeYAMLDocuments(v.ValueString())
+	if err != nil {
+		return false, diags
+	}
+	newDocuments, err := decodeYAMLDocuments(newValue.ValueString())
+	if err != nil {
+		return false, diags
+	}
+	return reflect.DeepEqual(oldDocuments, newDocuments), diags
+}
+
+func (v YAMLValue) ValidateAttribute(_ context.Context, req xattr.ValidateAttributeRequest, resp *xattr.ValidateAttributeResponse) {
+	if v.IsNull() || v.IsUnknown() {
+		return
+	}
+
+	if _, err := decodeYAMLDocuments(v.ValueString()); err != nil {
+		resp.Diagnostics.AddAttributeError(
+			req.Path,
+			"Invalid YAML",
+			fmt.Sprintf("Value is not valid YAML: %s.", err),
+		)
+	}
+}
+
+// decodeYAMLDocuments decodes every document of a YAML stream.
+func decodeYAMLDocuments(s string) ([]interface{}, error) {
+	var documents []interface{}
+	decoder := yaml.NewDecoder(strings.NewReader(s))
+	for {
+		var document interface{}
+		if err := decoder.Decode(&document); err != nil {
+			if errors.Is(err, io.EOF) {
+				return documents, nil
+			}
+			return nil, err
+		}
+		documents = append(documents, document)
+	}
+}